@@ -14,4 +14,41 @@ var (
 
 	// ErrorTimeFieldNotSupported is returned when a time field sent is not supported / recognized. This can be returned when querying for any data that has multiple time fields, like Issues and Pull Requests
 	ErrorTimeFieldNotSupported = errors.New("the selected time field is not supported")
+
+	// ErrorContributionRangeTooLong is returned when the contribution calendar of a user is requested for a time range longer than the one year that GitHub allows
+	ErrorContributionRangeTooLong = errors.New("the time range of a contribution calendar can not be longer than one year")
+
+	// ErrorSecretScanningDisabled is returned when the secret scanning alerts of a repository are requested, but GitHub responds with a 404 because secret scanning is not enabled or the repository could not be found
+	ErrorSecretScanningDisabled = errors.New("secret scanning is disabled for this repository, or the repository could not be found")
+
+	// ErrorDeployKeysForbidden is returned when the deploy keys of a repository are requested, but GitHub responds with a 403 or 404 because the access token does not have admin access to the repository
+	ErrorDeployKeysForbidden = errors.New("the access token is not allowed to read the deploy keys of this repository, which requires admin access, or the repository could not be found")
+
+	// ErrorRulesetsNotFound is returned when the rulesets of a repository or organization are requested, but GitHub responds with a 404. GitHub responds with an empty list if there are no rulesets,
+	// so a 404 means that the repository or organization does not exist, or that the access token can not read it
+	ErrorRulesetsNotFound = errors.New("the repository or organization could not be found, or the access token is not allowed to read its rulesets")
+
+	// ErrorAuditLogUnavailable is returned when the audit log of an organization is requested, but GitHub responds with a 403 or 404 because the organization is not on GitHub Enterprise, or the access token does not have the read:audit_log scope
+	ErrorAuditLogUnavailable = errors.New("the audit log of this organization is not available, which requires GitHub Enterprise and an access token with the read:audit_log scope, or the organization could not be found")
+
+	// ErrorMissingScope is returned when GitHub refuses a request because the access token does not have a scope that the query needs. The error starts with the name of the scope and ends with the message from GitHub
+	ErrorMissingScope = errors.New("GitHub refused the request because the access token is missing a scope")
+
+	// ErrorRepositoryIDNotFound is returned when a query uses a repository node ID that does not belong to a repository that the access token can read
+	ErrorRepositoryIDNotFound = errors.New("no repository was found with this node ID, or the access token is not allowed to read it (private repositories need the repo scope)")
+
+	// ErrorWorkflowDispatchDisabled is returned when a workflow run is triggered, but triggering workflows is not enabled in the datasource settings
+	ErrorWorkflowDispatchDisabled = errors.New("triggering workflows is not enabled for this datasource")
+
+	// ErrorInvalidCACert is returned when the CA certificate in the datasource settings does not contain any PEM encoded certificate
+	ErrorInvalidCACert = errors.New("the CA certificate could not be parsed, it has to be PEM encoded")
+
+	// ErrorInvalidTimezone is returned when the time zone that the time buckets of a query are aligned in is not a known IANA time zone name
+	ErrorInvalidTimezone = errors.New("the time zone is not a known IANA time zone name (ex: Europe/Berlin)")
+
+	// ErrorWorkflowRefMissing is returned when a workflow run is triggered without a git reference (branch or tag)
+	ErrorWorkflowRefMissing = errors.New("a branch or tag is required to trigger a workflow")
+
+	// ErrorInvalidPathSegment is returned when an owner, repository, or workflow that is used in the path of a REST request contains a character that would change the path
+	ErrorInvalidPathSegment = errors.New("the owner, repository, and workflow can not contain '/', '\\', '?', '#', or '..'")
 )
@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// These are the values of the `type` column of the activity feed
+const (
+	ActivityTypeIssue       = "issue"
+	ActivityTypePullRequest = "pull_request"
+)
+
+// ActivityItem is the part of an issue or pull request that is shown in the activity feed
+type ActivityItem struct {
+	Number     int64
+	Title      string
+	URL        string
+	State      string
+	Author     Author
+	UpdatedAt  githubv4.DateTime
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+// Activity is an issue or pull request in the activity feed
+type Activity struct {
+	ActivityItem
+
+	// Type is either ActivityTypeIssue or ActivityTypePullRequest
+	Type string
+}
+
+// Activities is a list of issues and pull requests, sorted by the time they were last updated (newest first)
+type Activities []Activity
+
+// Frames converts the activity feed to a Grafana DataFrame. The time is the first column so that the frame can be shown as a timeline
+func (a Activities) Frames() data.Frames {
+	frame := data.NewFrame(
+		"activity",
+		data.NewField("updated_at", nil, []time.Time{}),
+		data.NewField("type", nil, []string{}),
+		data.NewField("number", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("author_login", nil, []string{}),
+		data.NewField("state", nil, []string{}),
+		data.NewField("repository", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+	)
+
+	for _, v := range a {
+		frame.AppendRow(
+			v.UpdatedAt.Time,
+			v.Type,
+			v.Number,
+			v.Title,
+			v.Author.Login,
+			v.State,
+			v.Repository.NameWithOwner,
+			v.URL,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QuerySearchActivity is the GraphQL query for the most recently updated issues and pull requests
+// {
+//   search(query: "repo:grafana/grafana updated:2020-08-19..2020-08-20 sort:updated-desc", type: ISSUE, first: 100) {
+//     nodes {
+//       __typename
+//       ... on Issue {
+//         number
+//         title
+//         updatedAt
+//       }
+//       ... on PullRequest {
+//         number
+//         title
+//         updatedAt
+//       }
+//     }
+//   }
+// }
+type QuerySearchActivity struct {
+	Search struct {
+		Nodes []struct {
+			Typename    string       `graphql:"__typename"`
+			Issue       ActivityItem `graphql:"... on Issue"`
+			PullRequest ActivityItem `graphql:"... on PullRequest"`
+		}
+		PageInfo PageInfo
+	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
+}
+
+// activityQuery builds the search for the issues and pull requests that were updated in the time range, newest first.
+// Without an "is:issue" or "is:pr" qualifier, GitHub searches both, so a single search returns the merged feed
+func activityQuery(opts models.ListActivityOptions, from time.Time, to time.Time) string {
+	search := []string{
+		issueSearchScope(models.ListIssuesOptions{Owner: opts.Owner, Repository: opts.Repository}),
+		fmt.Sprintf("updated:%s..%s", from.Format(time.RFC3339), to.Format(time.RFC3339)),
+	}
+
+	if opts.Query != nil {
+		search = append(search, *opts.Query)
+	}
+
+	return strings.Join(append(search, "sort:updated-desc"), " ")
+}
+
+// GetActivityInRange lists the issues and pull requests of a repository or organization that were updated most recently in the time range, up to the Limit option
+func GetActivityInRange(ctx context.Context, client Client, opts models.ListActivityOptions, from time.Time, to time.Time) (Activities, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = models.DefaultActivityLimit
+	}
+
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"query":  githubv4.String(activityQuery(opts, from, to)),
+		}
+
+		activities = Activities{}
+	)
+
+	for {
+		q := &QuerySearchActivity{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Search.Nodes {
+			if int64(len(activities)) >= limit {
+				return activities, nil
+			}
+
+			switch v.Typename {
+			case "Issue":
+				activities = append(activities, Activity{ActivityItem: v.Issue, Type: ActivityTypeIssue})
+			case "PullRequest":
+				activities = append(activities, Activity{ActivityItem: v.PullRequest, Type: ActivityTypePullRequest})
+			}
+		}
+
+		if !q.Search.PageInfo.HasNextPage || int64(len(activities)) >= limit {
+			break
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetActivity(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("query", "cursor"),
+		testutil.GetTestQueryFunction(&QuerySearchActivity{}),
+	)
+
+	_, err := GetActivityInRange(context.Background(), client, models.ListActivityOptions{Owner: "grafana", Repository: "grafana"}, time.Now().Add(-7*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestActivityQuery(t *testing.T) {
+	var (
+		from = time.Date(2020, 8, 19, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("a repository should be searched if it is set", func(t *testing.T) {
+		var (
+			result = activityQuery(models.ListActivityOptions{Owner: "grafana", Repository: "grafana"}, from, to)
+			expect = "repo:grafana/grafana updated:2020-08-19T00:00:00Z..2020-08-20T00:00:00Z sort:updated-desc"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from activityQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("the organization should be searched if no repository is set", func(t *testing.T) {
+		query := "label:type/bug"
+		var (
+			result = activityQuery(models.ListActivityOptions{Owner: "grafana", Query: &query}, from, to)
+			expect = "org:grafana updated:2020-08-19T00:00:00Z..2020-08-20T00:00:00Z label:type/bug sort:updated-desc"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from activityQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+}
+
+// activityClient responds to every page of the activity search with an issue and a pull request, and always has another page
+type activityClient struct {
+	pages int
+}
+
+func (c *activityClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	c.pages++
+
+	query := q.(*QuerySearchActivity)
+	query.Search.Nodes = make([]struct {
+		Typename    string       `graphql:"__typename"`
+		Issue       ActivityItem `graphql:"... on Issue"`
+		PullRequest ActivityItem `graphql:"... on PullRequest"`
+	}, 2)
+	query.Search.Nodes[0].Typename = "Issue"
+	query.Search.Nodes[0].Issue.Number = int64(c.pages*2 - 1)
+	query.Search.Nodes[1].Typename = "PullRequest"
+	query.Search.Nodes[1].PullRequest.Number = int64(c.pages * 2)
+	query.Search.PageInfo.HasNextPage = true
+
+	return nil
+}
+
+func TestGetActivityLimit(t *testing.T) {
+	client := &activityClient{}
+
+	activities, err := GetActivityInRange(context.Background(), client, models.ListActivityOptions{Owner: "grafana", Limit: 3}, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(activities) != 3 || client.pages != 2 {
+		t.Fatalf("Expected 3 items from 2 pages, received %d items from %d pages", len(activities), client.pages)
+	}
+
+	if activities[1].Type != ActivityTypePullRequest || activities[2].Type != ActivityTypeIssue {
+		t.Fatalf("Expected the items to keep their type, received %+v", activities)
+	}
+}
+
+func TestActivityDataframe(t *testing.T) {
+	updatedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr := ActivityItem{
+		Number:    12,
+		Title:     "Add activity query",
+		URL:       "https://github.com/grafana/github-datasource/pull/12",
+		State:     "MERGED",
+		Author:    Author{Login: "testUser"},
+		UpdatedAt: githubv4.DateTime{Time: updatedAt},
+	}
+	pr.Repository.NameWithOwner = "grafana/github-datasource"
+
+	issue := ActivityItem{
+		Number:    11,
+		Title:     "Show recent activity",
+		URL:       "https://github.com/grafana/github-datasource/issues/11",
+		State:     "CLOSED",
+		Author:    Author{Login: "otherUser"},
+		UpdatedAt: githubv4.DateTime{Time: updatedAt.Add(-time.Hour)},
+	}
+	issue.Repository.NameWithOwner = "grafana/github-datasource"
+
+	activities := Activities{
+		{ActivityItem: pr, Type: ActivityTypePullRequest},
+		{ActivityItem: issue, Type: ActivityTypeIssue},
+	}
+
+	if err := testutil.CheckGoldenFramer("activity", activities); err != nil {
+		t.Fatal(err)
+	}
+}
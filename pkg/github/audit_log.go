@@ -0,0 +1,140 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// AuditLogEvent is a single event in the audit log of an organization
+type AuditLogEvent struct {
+	// Timestamp is the time of the event in milliseconds since the Unix epoch
+	Timestamp     int64  `json:"@timestamp"`
+	Action        string `json:"action"`
+	Actor         string `json:"actor"`
+	User          string `json:"user"`
+	Repository    string `json:"repo"`
+	Org           string `json:"org"`
+	ActorLocation struct {
+		CountryCode string `json:"country_code"`
+	} `json:"actor_location"`
+}
+
+// Time returns the time of the event
+func (e AuditLogEvent) Time() time.Time {
+	return time.Unix(0, e.Timestamp*int64(time.Millisecond)).UTC()
+}
+
+// AuditLogEvents is a list of audit log events, sorted by time with the most recent event first
+type AuditLogEvents []AuditLogEvent
+
+// Frames converts the list of audit log events to a Grafana DataFrame
+func (a AuditLogEvents) Frames() data.Frames {
+	frame := data.NewFrame(
+		"audit_log",
+		data.NewField("timestamp", nil, []time.Time{}),
+		data.NewField("action", nil, []string{}),
+		data.NewField("actor", nil, []string{}),
+		data.NewField("user", nil, []string{}),
+		data.NewField("repository", nil, []string{}),
+		data.NewField("org", nil, []string{}),
+		data.NewField("actor_country", nil, []string{}),
+	)
+
+	for _, v := range a {
+		frame.AppendRow(
+			v.Time(),
+			v.Action,
+			v.Actor,
+			v.User,
+			v.Repository,
+			v.Org,
+			v.ActorLocation.CountryCode,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// auditLogPhrase adds the days of the time range to the search phrase. The audit log can only be searched by day, so the events are also filtered by their exact time
+func auditLogPhrase(phrase string, from time.Time, to time.Time) string {
+	search := []string{
+		fmt.Sprintf("created:%s..%s", from.UTC().Format("2006-01-02"), to.UTC().Format("2006-01-02")),
+	}
+
+	if phrase = strings.TrimSpace(phrase); phrase != "" {
+		search = append(search, phrase)
+	}
+
+	return strings.Join(search, " ")
+}
+
+// GetAuditLogInRange lists the most recent events in the audit log of an organization within a time range using the REST API: /orgs/{org}/audit-log
+// The audit log is only available for organizations on GitHub Enterprise, and the access token needs the read:audit_log scope.
+func GetAuditLogInRange(ctx context.Context, client RESTClient, opts models.ListAuditLogOptions, from time.Time, to time.Time) (AuditLogEvents, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = models.DefaultAuditLogLimit
+	}
+
+	var (
+		path   = fmt.Sprintf("/orgs/%s/audit-log", opts.Owner)
+		params = url.Values{
+			"phrase":   []string{auditLogPhrase(opts.Phrase, from, to)},
+			"order":    []string{"desc"},
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+
+		events = AuditLogEvents{}
+	)
+
+	if opts.Include != "" {
+		params.Set("include", opts.Include)
+	}
+
+	for page := 1; int64(len(events)) < limit; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		e := AuditLogEvents{}
+		if err := client.Get(ctx, path, params, &e); err != nil {
+			return nil, auditLogError(err, opts)
+		}
+
+		for _, v := range e {
+			if t := v.Time(); t.Before(from) || t.After(to) {
+				continue
+			}
+
+			events = append(events, v)
+		}
+
+		if len(e) < RESTPageSize {
+			break
+		}
+	}
+
+	if int64(len(events)) > limit {
+		events = events[:limit]
+	}
+
+	return events, nil
+}
+
+// auditLogError replaces the 403 or 404 that GitHub returns when the audit log is not available with an error that explains its requirements
+func auditLogError(err error, opts models.ListAuditLogOptions) error {
+	var restErr *RESTError
+	if errors.As(err, &restErr) && (restErr.StatusCode == http.StatusForbidden || restErr.StatusCode == http.StatusNotFound) {
+		return errors.Wrap(dserrors.ErrorAuditLogUnavailable, opts.Owner)
+	}
+
+	return errors.WithStack(err)
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+func TestGetAuditLogInRange(t *testing.T) {
+	client := testutil.NewTestRESTClient(t,
+		testutil.GetTestRequestFunction("/orgs/grafana/audit-log", "phrase", "include", "order", "per_page", "page"),
+	)
+
+	opts := models.ListAuditLogOptions{Owner: "grafana", Phrase: "action:repo.access", Include: "all"}
+	_, err := GetAuditLogInRange(context.Background(), client, opts, time.Now().Add(-7*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAuditLogUnavailable(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusNotFound} {
+		client := &errorRESTClient{
+			err: &RESTError{StatusCode: status, Message: http.StatusText(status)},
+		}
+
+		_, err := GetAuditLogInRange(context.Background(), client, models.ListAuditLogOptions{Owner: "grafana"}, time.Time{}, time.Now())
+		if !errors.Is(err, dserrors.ErrorAuditLogUnavailable) {
+			t.Fatalf("Expected error '%s' for status %d, received '%v'", dserrors.ErrorAuditLogUnavailable, status, err)
+		}
+	}
+}
+
+func TestAuditLogPhrase(t *testing.T) {
+	var (
+		from = time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, 8, 31, 10, 0, 0, 0, time.UTC)
+	)
+
+	if phrase := auditLogPhrase(" action:repo.access ", from, to); phrase != "created:2020-08-01..2020-08-31 action:repo.access" {
+		t.Fatalf("Unexpected phrase: %s", phrase)
+	}
+
+	if phrase := auditLogPhrase("", from, to); phrase != "created:2020-08-01..2020-08-31" {
+		t.Fatalf("Unexpected phrase: %s", phrase)
+	}
+}
+
+func TestAuditLogDataframe(t *testing.T) {
+	timestamp, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberAdded := AuditLogEvent{
+		Timestamp: timestamp.UnixNano() / int64(time.Millisecond),
+		Action:    "org.add_member",
+		Actor:     "admin",
+		User:      "newMember",
+		Org:       "grafana",
+	}
+	memberAdded.ActorLocation.CountryCode = "SE"
+
+	repoPublic := AuditLogEvent{
+		Timestamp:  timestamp.Add(-time.Hour).UnixNano() / int64(time.Millisecond),
+		Action:     "repo.access",
+		Actor:      "maintainer",
+		Repository: "grafana/plugin",
+		Org:        "grafana",
+	}
+
+	if err := testutil.CheckGoldenFramer("audit_log", AuditLogEvents{memberAdded, repoPublic}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,105 @@
+package github
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+)
+
+// BucketCacheDuration is how long the counts of a time bucket that is entirely in the past are kept.
+// The counts of these buckets rarely change, so they are kept much longer than the results of whole queries
+const BucketCacheDuration = 24 * time.Hour
+
+type bucketCacheKey struct {
+	query    string
+	interval models.BucketInterval
+	start    int64
+}
+
+type bucketCacheEntry struct {
+	counts    []int64
+	expiresAt time.Time
+}
+
+// bucketCache stores the counts of the time buckets of long time series, so that only the buckets that can still change are fetched again when a dashboard is refreshed.
+// Entries are keyed on the query, so changing any of its options fetches every bucket again. A nil bucketCache does not cache anything
+type bucketCache struct {
+	mu      sync.Mutex
+	entries map[bucketCacheKey]bucketCacheEntry
+}
+
+func newBucketCache() *bucketCache {
+	return &bucketCache{
+		entries: map[bucketCacheKey]bucketCacheEntry{},
+	}
+}
+
+// bucketCacheQuery returns the part of the cache key that identifies the query. The time range is not part of the options, so overlapping time ranges share their buckets
+func bucketCacheQuery(opts interface{}) (string, bool) {
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return "", false
+	}
+
+	return string(b), true
+}
+
+// get returns the cached counts of the bucket starting at start, if they have not expired at now
+func (c *bucketCache) get(query string, interval models.BucketInterval, start time.Time, now time.Time) ([]int64, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[bucketCacheKey{query: query, interval: interval, start: start.Unix()}]
+	if !ok || entry.expiresAt.Before(now) {
+		return nil, false
+	}
+
+	return entry.counts, true
+}
+
+// set stores the counts of the bucket starting at start, and removes the entries that have expired at now
+func (c *bucketCache) set(query string, interval models.BucketInterval, start time.Time, counts []int64, now time.Time) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, v := range c.entries {
+		if v.expiresAt.Before(now) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[bucketCacheKey{query: query, interval: interval, start: start.Unix()}] = bucketCacheEntry{
+		counts:    counts,
+		expiresAt: now.Add(BucketCacheDuration),
+	}
+}
+
+// bucketIsFinal returns true if the bucket starting at start is entirely within the time range and entirely before now, so that its counts can be cached.
+// The first bucket usually starts before the time range, so its counts only cover part of it and are not cached
+func bucketIsFinal(start time.Time, interval models.BucketInterval, from time.Time, to time.Time, now time.Time) bool {
+	end := nextBucket(start, interval)
+	return !start.Before(from) && !end.After(to) && !end.After(now)
+}
+
+// bucketRuns groups the indexes of the buckets that are not cached into runs of consecutive buckets, so that every run can be fetched with a single search
+func bucketRuns(indexes []int) [][]int {
+	runs := [][]int{}
+	for i, v := range indexes {
+		if i == 0 || v != indexes[i-1]+1 {
+			runs = append(runs, []int{})
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], v)
+	}
+
+	return runs
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/pkg/errors"
+)
+
+// bucketLocation returns the time zone with the IANA name (ex: Europe/Berlin) that the buckets are aligned in, or UTC if the name is empty
+func bucketLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.Wrap(dserrors.ErrorInvalidTimezone, timezone)
+	}
+
+	return loc, nil
+}
+
+// bucketStart returns the start of the time bucket that contains t. Buckets start at midnight in loc, or in UTC if loc is nil
+func bucketStart(t time.Time, interval models.BucketInterval, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+
+	switch interval {
+	case models.BucketWeek:
+		// time.Weekday starts on Sunday; shift it so that weeks start on Monday
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case models.BucketMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	}
+
+	return day
+}
+
+// nextBucket returns the start of the time bucket that follows the bucket starting at t. The bucket is in the time zone of t, so days that are not 24 hours long because of daylight saving time still end at midnight
+func nextBucket(t time.Time, interval models.BucketInterval) time.Time {
+	switch interval {
+	case models.BucketWeek:
+		return t.AddDate(0, 0, 7)
+	case models.BucketMonth:
+		return t.AddDate(0, 1, 0)
+	}
+
+	return t.AddDate(0, 0, 1)
+}
+
+// bucketCounts groups the times into buckets and counts the number of times in each.
+// The buckets are returned in order, and empty buckets between the first and the last bucket are included so that the result can be charted directly.
+func bucketCounts(times []time.Time, interval models.BucketInterval, loc *time.Location) ([]time.Time, []int64) {
+	if len(times) == 0 {
+		return []time.Time{}, []int64{}
+	}
+
+	var (
+		counts      = map[time.Time]int64{}
+		first, last time.Time
+	)
+
+	for i, v := range times {
+		start := bucketStart(v, interval, loc)
+		counts[start]++
+
+		if i == 0 || start.Before(first) {
+			first = start
+		}
+		if i == 0 || start.After(last) {
+			last = start
+		}
+	}
+
+	var (
+		buckets = []time.Time{}
+		values  = []int64{}
+	)
+
+	for t := first; !t.After(last); t = nextBucket(t, interval) {
+		buckets = append(buckets, t)
+		values = append(values, counts[t])
+	}
+
+	return buckets, values
+}
+
+// bucketsInRange returns the start of every time bucket that overlaps with the time range
+func bucketsInRange(from time.Time, to time.Time, interval models.BucketInterval, loc *time.Location) []time.Time {
+	buckets := []time.Time{}
+	for t := bucketStart(from, interval, loc); !t.After(to); t = nextBucket(t, interval) {
+		buckets = append(buckets, t)
+	}
+
+	return buckets
+}
+
+// countInBuckets counts the number of times that fall in each of the buckets. Times that are outside of the buckets are not counted
+func countInBuckets(times []time.Time, buckets []time.Time, interval models.BucketInterval, loc *time.Location) []int64 {
+	index := make(map[time.Time]int, len(buckets))
+	for i, v := range buckets {
+		index[v] = i
+	}
+
+	counts := make([]int64, len(buckets))
+	for _, v := range times {
+		if i, ok := index[bucketStart(v, interval, loc)]; ok {
+			counts[i]++
+		}
+	}
+
+	return counts
+}
+
+// intervalBucket returns the largest bucket interval that is not larger than the query interval (for example, the interval that Grafana picks for a panel)
+func intervalBucket(interval time.Duration) models.BucketInterval {
+	switch {
+	case interval >= 28*24*time.Hour:
+		return models.BucketMonth
+	case interval >= 7*24*time.Hour:
+		return models.BucketWeek
+	}
+
+	return models.BucketDay
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+)
+
+func TestBucketStart(t *testing.T) {
+	// 2020-08-27 is a Thursday
+	ts := time.Date(2020, time.August, 27, 16, 21, 56, 0, time.UTC)
+
+	for _, tc := range []struct {
+		interval models.BucketInterval
+		expected time.Time
+	}{
+		{interval: models.BucketDay, expected: time.Date(2020, time.August, 27, 0, 0, 0, 0, time.UTC)},
+		{interval: models.BucketWeek, expected: time.Date(2020, time.August, 24, 0, 0, 0, 0, time.UTC)},
+		{interval: models.BucketMonth, expected: time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		if start := bucketStart(ts, tc.interval, time.UTC); !start.Equal(tc.expected) {
+			t.Errorf("unexpected start of %s bucket. Expected '%s', received '%s'", tc.interval, tc.expected, start)
+		}
+	}
+
+	// Sundays belong to the week that started on the previous Monday
+	sunday := time.Date(2020, time.August, 30, 23, 0, 0, 0, time.UTC)
+	if start := bucketStart(sunday, models.BucketWeek, time.UTC); !start.Equal(time.Date(2020, time.August, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected start of week bucket for a Sunday: '%s'", start)
+	}
+}
+
+func TestBucketCounts(t *testing.T) {
+	times := []time.Time{
+		time.Date(2020, time.August, 3, 10, 0, 0, 0, time.UTC),
+		time.Date(2020, time.August, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2020, time.August, 3, 12, 0, 0, 0, time.UTC),
+	}
+
+	buckets, counts := bucketCounts(times, models.BucketDay, time.UTC)
+
+	expected := []int64{1, 0, 2}
+	if len(buckets) != len(expected) || len(counts) != len(expected) {
+		t.Fatalf("expected %d buckets, received %d", len(expected), len(buckets))
+	}
+
+	for i, v := range expected {
+		if counts[i] != v {
+			t.Errorf("unexpected count in bucket '%s'. Expected %d, received %d", buckets[i], v, counts[i])
+		}
+	}
+}
+
+func TestCountInBuckets(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 12, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.October, 15, 0, 0, 0, 0, time.UTC)
+	)
+
+	buckets := bucketsInRange(from, to, models.BucketMonth, time.UTC)
+	if len(buckets) != 3 {
+		t.Fatalf("expected 3 monthly buckets, received %d", len(buckets))
+	}
+
+	counts := countInBuckets([]time.Time{
+		time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.August, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.October, 31, 0, 0, 0, 0, time.UTC),
+	}, buckets, models.BucketMonth, time.UTC)
+
+	expected := []int64{1, 0, 2}
+	for i, v := range expected {
+		if counts[i] != v {
+			t.Errorf("unexpected count in bucket '%s'. Expected %d, received %d", buckets[i], v, counts[i])
+		}
+	}
+}
+
+func TestBucketStartInTimezone(t *testing.T) {
+	loc, err := bucketLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 02:00 UTC on a Tuesday is still Monday evening in New York
+	ts := time.Date(2020, time.September, 1, 2, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		interval models.BucketInterval
+		expected time.Time
+	}{
+		{interval: models.BucketDay, expected: time.Date(2020, time.August, 31, 0, 0, 0, 0, loc)},
+		{interval: models.BucketWeek, expected: time.Date(2020, time.August, 31, 0, 0, 0, 0, loc)},
+		{interval: models.BucketMonth, expected: time.Date(2020, time.August, 1, 0, 0, 0, 0, loc)},
+	} {
+		if start := bucketStart(ts, tc.interval, loc); !start.Equal(tc.expected) {
+			t.Errorf("unexpected start of %s bucket. Expected '%s', received '%s'", tc.interval, tc.expected, start)
+		}
+	}
+
+	// The day that daylight saving time ends is 25 hours long, and the next bucket still starts at midnight
+	start := time.Date(2020, time.November, 1, 0, 0, 0, 0, loc)
+	if next := nextBucket(start, models.BucketDay); !next.Equal(time.Date(2020, time.November, 2, 0, 0, 0, 0, loc)) || next.Sub(start) != 25*time.Hour {
+		t.Errorf("unexpected start of the bucket after the end of daylight saving time: '%s'", next)
+	}
+}
+
+func TestBucketLocation(t *testing.T) {
+	loc, err := bucketLocation("")
+	if err != nil || loc != time.UTC {
+		t.Fatalf("expected UTC if the time zone is empty, received '%v' (%v)", loc, err)
+	}
+
+	if _, err := bucketLocation("Mars/Olympus_Mons"); !errors.Is(err, dserrors.ErrorInvalidTimezone) {
+		t.Fatalf("expected an invalid time zone error, received '%v'", err)
+	}
+}
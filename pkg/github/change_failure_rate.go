@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// ChangeFailureRate is the number of deployments and of failed deployments in a time range, and optionally in every time bucket of the range
+type ChangeFailureRate struct {
+	Deployments int64
+	Failed      int64
+
+	Bucket            models.BucketInterval
+	Buckets           []time.Time
+	BucketDeployments []int64
+	BucketFailed      []int64
+}
+
+// failureRate returns the share of the deployments that failed, or nil if there were no deployments
+func failureRate(deployments int64, failed int64) *float64 {
+	if deployments == 0 {
+		return nil
+	}
+
+	rate := float64(failed) / float64(deployments)
+	return &rate
+}
+
+// Frames converts the change failure rate to a Grafana DataFrame with a single row, or with one row per bucket if the deployments are counted in time buckets.
+// The rate is a fraction of 1, and it is null if there were no deployments
+func (c ChangeFailureRate) Frames() data.Frames {
+	rate := data.NewField("change_failure_rate", nil, []*float64{})
+	rate.Config = &data.FieldConfig{
+		Unit: "percentunit",
+	}
+
+	if c.Bucket != models.BucketNone {
+		for i := range c.Buckets {
+			rate.Append(failureRate(c.BucketDeployments[i], c.BucketFailed[i]))
+		}
+
+		frame := data.NewFrame(
+			"change_failure_rate",
+			data.NewField("time", nil, c.Buckets),
+			data.NewField("deployments", nil, c.BucketDeployments),
+			data.NewField("failed_deployments", nil, c.BucketFailed),
+			rate,
+		)
+
+		return data.Frames{frame}
+	}
+
+	rate.Append(failureRate(c.Deployments, c.Failed))
+
+	frame := data.NewFrame(
+		"change_failure_rate",
+		data.NewField("deployments", nil, []int64{c.Deployments}),
+		data.NewField("failed_deployments", nil, []int64{c.Failed}),
+		rate,
+	)
+
+	return data.Frames{frame}
+}
+
+// failureStates converts the comma separated list of deployment states to a set. The states are not case-sensitive
+func failureStates(states string) map[string]bool {
+	if strings.TrimSpace(states) == "" {
+		states = models.DefaultDeploymentFailureStates
+	}
+
+	set := map[string]bool{}
+	for _, v := range strings.Split(states, ",") {
+		if v = strings.ToUpper(strings.TrimSpace(v)); v != "" {
+			set[v] = true
+		}
+	}
+
+	return set
+}
+
+// deploymentFailed returns true if the deployment, or any of its statuses, is in one of the failure states
+func deploymentFailed(d Deployment, states map[string]bool) bool {
+	if states[string(d.State)] {
+		return true
+	}
+
+	for _, v := range d.Statuses.Nodes {
+		if states[string(v.State)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changeFailureRate counts the deployments and the failed deployments. If the bucket interval is set, they are also counted in every bucket of the time range.
+// The buckets are aligned in loc. A zero `from` or `to` is replaced with the time of the oldest or newest deployment
+func changeFailureRate(deployments Deployments, opts models.ListChangeFailureRateOptions, loc *time.Location, from time.Time, to time.Time) ChangeFailureRate {
+	var (
+		states = failureStates(opts.FailureStates)
+		all    = []time.Time{}
+		failed = []time.Time{}
+	)
+
+	for _, v := range deployments {
+		all = append(all, v.CreatedAt.Time)
+		if deploymentFailed(v, states) {
+			failed = append(failed, v.CreatedAt.Time)
+		}
+	}
+
+	rate := ChangeFailureRate{
+		Deployments: int64(len(all)),
+		Failed:      int64(len(failed)),
+		Bucket:      opts.Bucket,
+	}
+
+	if opts.Bucket != models.BucketNone {
+		from, to = deploymentsRange(deployments, from, to)
+		rate.Buckets = bucketsInRange(from, to, opts.Bucket, loc)
+		rate.BucketDeployments = countInBuckets(all, rate.Buckets, opts.Bucket, loc)
+		rate.BucketFailed = countInBuckets(failed, rate.Buckets, opts.Bucket, loc)
+	}
+
+	return rate
+}
+
+// GetChangeFailureRate computes the share of the deployments of a repository that were created within the time range and failed, which is the change failure rate of the DORA metrics
+func GetChangeFailureRate(ctx context.Context, client Client, opts models.ListChangeFailureRateOptions, from time.Time, to time.Time) (ChangeFailureRate, error) {
+	loc, err := bucketLocation(opts.Timezone)
+	if err != nil {
+		return ChangeFailureRate{}, err
+	}
+
+	deployments, err := GetDeploymentsInRange(ctx, client, models.ListDeploymentsOptions{
+		Owner:        opts.Owner,
+		Repository:   opts.Repository,
+		Environments: opts.Environments,
+	}, from, to)
+	if err != nil {
+		return ChangeFailureRate{}, err
+	}
+
+	return changeFailureRate(deployments, opts, loc, from, to), nil
+}
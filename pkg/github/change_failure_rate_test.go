@@ -0,0 +1,82 @@
+package github
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetChangeFailureRate(t *testing.T) {
+	opts := models.ListChangeFailureRateOptions{
+		Repository:   "grafana",
+		Owner:        "grafana",
+		Environments: "production",
+	}
+
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("cursor", "name", "owner", "environments"),
+		testutil.GetTestQueryFunction(&QueryListDeployments{}),
+	)
+
+	_, err := GetChangeFailureRate(context.Background(), client, opts, time.Now().Add(-30*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFailureStates(t *testing.T) {
+	if states := failureStates(""); !reflect.DeepEqual(states, map[string]bool{"FAILURE": true, "ERROR": true}) {
+		t.Fatalf("Unexpected default failure states: %v", states)
+	}
+
+	if states := failureStates(" failure, inactive ,"); !reflect.DeepEqual(states, map[string]bool{"FAILURE": true, "INACTIVE": true}) {
+		t.Fatalf("Unexpected failure states: %v", states)
+	}
+}
+
+func TestDeploymentFailedStatuses(t *testing.T) {
+	// The deployment was replaced by a newer one after it failed, so only its statuses show the failure
+	deployment := Deployment{State: githubv4.DeploymentStateInactive}
+	deployment.Statuses.Nodes = DeploymentStatuses{
+		{State: githubv4.DeploymentStatusStateInactive},
+		{State: githubv4.DeploymentStatusStateFailure},
+	}
+
+	if !deploymentFailed(deployment, failureStates("")) {
+		t.Fatal("Expected the deployment with a failed status to be failed")
+	}
+
+	if deploymentFailed(Deployment{State: githubv4.DeploymentStateActive}, failureStates("")) {
+		t.Fatal("Expected the active deployment to not be failed")
+	}
+}
+
+func TestChangeFailureRateDataframe(t *testing.T) {
+	rate := changeFailureRate(frequencyDeployments(), models.ListChangeFailureRateOptions{}, time.UTC, time.Time{}, time.Time{})
+
+	if err := testutil.CheckGoldenFramer("change_failure_rate", rate); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChangeFailureRateBucketedDataframe(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 16, 0, 0, 0, 0, time.UTC)
+		opts = models.ListChangeFailureRateOptions{
+			FailureStates: "FAILURE",
+			Bucket:        models.BucketWeek,
+		}
+	)
+
+	rate := changeFailureRate(frequencyDeployments(), opts, time.UTC, from, to)
+
+	if err := testutil.CheckGoldenFramer("change_failure_rate_buckets", rate); err != nil {
+		t.Fatal(err)
+	}
+}
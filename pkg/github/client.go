@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"net/url"
 )
 
 // The Client interface is satisfied by the githubv4.Client type.
@@ -9,3 +10,10 @@ import (
 type Client interface {
 	Query(ctx context.Context, q interface{}, variables map[string]interface{}) error
 }
+
+// The RESTClient interface is used for the GitHub resources that are only available in the REST (v3) API, like secret scanning alerts.
+// Like the Client interface, functions accept this interface rather than a concrete type.
+type RESTClient interface {
+	Get(ctx context.Context, path string, params url.Values, v interface{}) error
+	Post(ctx context.Context, path string, body interface{}, v interface{}) error
+}
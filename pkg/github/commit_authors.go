@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// CommitAuthor is a git author along with the number of commits, and optionally the added and deleted lines, in a time range
+type CommitAuthor struct {
+	Author    GitActor
+	Commits   int64
+	Additions int64
+	Deletions int64
+}
+
+// CommitAuthors is a list of git authors and their number of commits, sorted by the number of commits
+type CommitAuthors struct {
+	Authors []CommitAuthor
+
+	// Stats adds the additions and deletions columns to the data frame
+	Stats bool
+}
+
+// Frames converts the list of commit authors to a Grafana DataFrame
+func (c CommitAuthors) Frames() data.Frames {
+	fields := []*data.Field{
+		data.NewField("author", nil, []string{}),
+		data.NewField("author_login", nil, []string{}),
+		data.NewField("author_email", nil, []string{}),
+		data.NewField("commits", nil, []int64{}),
+	}
+
+	if c.Stats {
+		fields = append(fields,
+			data.NewField("additions", nil, []int64{}),
+			data.NewField("deletions", nil, []int64{}),
+		)
+	}
+
+	frame := data.NewFrame("commit_authors", fields...)
+
+	for _, v := range c.Authors {
+		values := []interface{}{
+			v.Author.Name,
+			v.Author.User.Login,
+			v.Author.Email,
+			v.Commits,
+		}
+
+		if c.Stats {
+			values = append(values, v.Additions, v.Deletions)
+		}
+
+		frame.AppendRow(values...)
+	}
+
+	return data.Frames{frame}
+}
+
+// authorCommit is a commit along with its changed lines, which are only selected if the includeStats variable is true
+type authorCommit struct {
+	Author    GitActor
+	Additions int64 `graphql:"additions @include(if: $includeStats)"`
+	Deletions int64 `graphql:"deletions @include(if: $includeStats)"`
+}
+
+// QueryListCommitAuthorsInRange is the graphql query for retrieving the authors (and optionally the changed lines) of the commits within a time range
+// {
+//   repository(name: "grafana", owner: "grafana") {
+//     object(expression: "main") {
+//       ... on Commit {
+//         history(first: 100, since: "2020-08-01T00:00:00Z", until: "2020-09-01T00:00:00Z") {
+//           nodes {
+//             author {
+//               name
+//               email
+//             }
+//             additions
+//             deletions
+//           }
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryListCommitAuthorsInRange struct {
+	Repository struct {
+		Object struct {
+			Commit struct {
+				History struct {
+					Nodes    []authorCommit
+					PageInfo PageInfo
+				} `graphql:"history(first: 100, after: $cursor, since: $since, until: $until)"`
+			} `graphql:"... on Commit"`
+		} `graphql:"object(expression: $ref)"`
+	} `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// GetCommitAuthorsInRange counts the commits of every author in a repository within a time range.
+// Authors are grouped by their (case-insensitive) git email, and sorted by their number of commits.
+// If the Limit option is set, only that many authors with the most commits are returned.
+func GetCommitAuthorsInRange(ctx context.Context, client Client, opts models.ListCommitAuthorsOptions, from time.Time, to time.Time) (CommitAuthors, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor":       (*githubv4.String)(nil),
+			"name":         githubv4.String(opts.Repository),
+			"owner":        githubv4.String(opts.Owner),
+			"ref":          githubv4.String(opts.Ref),
+			"since":        githubv4.GitTimestamp{Time: from},
+			"until":        githubv4.GitTimestamp{Time: to},
+			"includeStats": githubv4.Boolean(opts.Stats),
+		}
+
+		authors = []CommitAuthor{}
+		index   = map[string]int{}
+	)
+
+	for {
+		q := &QueryListCommitAuthorsInRange{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return CommitAuthors{}, errors.WithStack(err)
+		}
+
+		for _, v := range q.Repository.Object.Commit.History.Nodes {
+			key := strings.ToLower(strings.TrimSpace(v.Author.Email))
+			i, ok := index[key]
+			if !ok {
+				i = len(authors)
+				index[key] = i
+				authors = append(authors, CommitAuthor{Author: v.Author})
+			}
+
+			if authors[i].Author.User.Login == "" && v.Author.User.Login != "" {
+				authors[i].Author = v.Author
+			}
+
+			authors[i].Commits++
+			authors[i].Additions += v.Additions
+			authors[i].Deletions += v.Deletions
+		}
+
+		if !q.Repository.Object.Commit.History.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Repository.Object.Commit.History.PageInfo.EndCursor
+	}
+
+	// Authors are kept in the order of their first commit if they have the same number of commits
+	sort.SliceStable(authors, func(i, j int) bool {
+		return authors[i].Commits > authors[j].Commits
+	})
+
+	if opts.Limit > 0 && int64(len(authors)) > opts.Limit {
+		authors = authors[:opts.Limit]
+	}
+
+	return CommitAuthors{Authors: authors, Stats: opts.Stats}, nil
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+// commitAuthorsClient responds to the commit authors query with a single page of commits
+type commitAuthorsClient struct {
+	commits []authorCommit
+}
+
+func (c *commitAuthorsClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	q.(*QueryListCommitAuthorsInRange).Repository.Object.Commit.History.Nodes = c.commits
+	return nil
+}
+
+func TestListCommitAuthors(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListCommitAuthorsOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+			Ref:        "main",
+			Stats:      true,
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("name", "owner", "ref", "since", "until", "includeStats")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryListCommitAuthorsInRange{}),
+	)
+
+	_, err := GetCommitAuthorsInRange(ctx, client, opts, time.Now().Add(-30*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCommitAuthorsDataframe(t *testing.T) {
+	var (
+		first = GitActor{
+			Name:  "First User",
+			Email: "first@example.com",
+		}
+		firstLinked = GitActor{
+			Name:  "First User",
+			Email: "First@example.com",
+			User: User{
+				Login: "firstUser",
+			},
+		}
+		second = GitActor{
+			Name:  "Second User",
+			Email: "second@example.com",
+		}
+		third = GitActor{
+			Name:  "Third User",
+			Email: "third@example.com",
+		}
+	)
+
+	client := &commitAuthorsClient{
+		commits: []authorCommit{
+			{Author: second, Additions: 10, Deletions: 1},
+			{Author: first, Additions: 5, Deletions: 5},
+			{Author: third, Additions: 1, Deletions: 0},
+			{Author: firstLinked, Additions: 20, Deletions: 2},
+			{Author: second, Additions: 3, Deletions: 3},
+			{Author: first, Additions: 1, Deletions: 1},
+		},
+	}
+
+	opts := models.ListCommitAuthorsOptions{
+		Stats: true,
+		Limit: 2,
+	}
+
+	authors, err := GetCommitAuthorsInRange(context.Background(), client, opts, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testutil.CheckGoldenFramer("commit_authors", authors); err != nil {
+		t.Fatal(err)
+	}
+}
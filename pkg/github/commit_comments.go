@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// CommitComment is a comment on a commit, or on a line of a file changed by the commit
+type CommitComment struct {
+	Author    Author
+	Body      string `graphql:"bodyText"`
+	Path      string
+	Position  *int64
+	URL       string
+	CreatedAt githubv4.DateTime
+	Commit    struct {
+		OID string
+	}
+}
+
+// CommitComments is a list of commit comments
+type CommitComments []CommitComment
+
+// Frames converts the list of commit comments to a Grafana DataFrame
+func (c CommitComments) Frames() data.Frames {
+	return CommitCommentsWrapper{Comments: c}.Frames()
+}
+
+// CommitCommentsWrapper is a list of commit comments along with the query options that change how they are converted to a data frame
+type CommitCommentsWrapper struct {
+	Comments CommitComments
+	Options  models.ListCommitCommentsOptions
+}
+
+// Frames converts the list of commit comments to a Grafana DataFrame. The text of the comments is cut off after the BodyMaxLength option
+func (w CommitCommentsWrapper) Frames() data.Frames {
+	frame := data.NewFrame(
+		"commit_comments",
+		data.NewField("commit", nil, []string{}),
+		data.NewField("author_login", nil, []string{}),
+		data.NewField("body", nil, []string{}),
+		data.NewField("path", nil, []string{}),
+		data.NewField("position", nil, []*int64{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+	)
+
+	for _, v := range w.Comments {
+		frame.AppendRow(
+			v.Commit.OID,
+			v.Author.Login,
+			truncateBody(v.Body, w.Options.BodyMaxLength),
+			v.Path,
+			v.Position,
+			v.URL,
+			v.CreatedAt.Time,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QueryListCommitComments lists the comments on the commits of a repository
+// {
+//   repository(name: "grafana", owner: "grafana") {
+//     commitComments(first: 100) {
+//       nodes {
+//         bodyText
+//         path
+//         position
+//         createdAt
+//       }
+//     }
+//   }
+// }
+type QueryListCommitComments struct {
+	Repository struct {
+		CommitComments struct {
+			Nodes    CommitComments
+			PageInfo PageInfo
+		} `graphql:"commitComments(first: 100, after: $cursor)"`
+	} `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// GetCommitCommentsInRange lists the comments on the commits of a repository that were created in the time range.
+// GitHub can not filter the comments by time, so every comment of the repository is retrieved.
+func GetCommitCommentsInRange(ctx context.Context, client Client, opts models.ListCommitCommentsOptions, from time.Time, to time.Time) (CommitComments, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"owner":  githubv4.String(opts.Owner),
+			"name":   githubv4.String(opts.Repository),
+		}
+
+		comments = CommitComments{}
+	)
+
+	for {
+		q := &QueryListCommitComments{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Repository.CommitComments.Nodes {
+			if v.CreatedAt.Before(from) || v.CreatedAt.After(to) {
+				continue
+			}
+			comments = append(comments, v)
+		}
+
+		if !q.Repository.CommitComments.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Repository.CommitComments.PageInfo.EndCursor
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestListCommitComments(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("name", "owner", "cursor"),
+		testutil.GetTestQueryFunction(&QueryListCommitComments{}),
+	)
+
+	_, err := GetCommitCommentsInRange(context.Background(), client, models.ListCommitCommentsOptions{Owner: "grafana", Repository: "grafana"}, time.Now().Add(-7*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// commitCommentsClient responds to the commit comments query with a single page of comments
+type commitCommentsClient struct {
+	comments CommitComments
+}
+
+func (c *commitCommentsClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	q.(*QueryListCommitComments).Repository.CommitComments.Nodes = c.comments
+	return nil
+}
+
+func TestCommitCommentsInRange(t *testing.T) {
+	from := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(7 * 24 * time.Hour)
+
+	client := &commitCommentsClient{
+		comments: CommitComments{
+			{Body: "before", CreatedAt: githubv4.DateTime{Time: from.Add(-time.Hour)}},
+			{Body: "inside", CreatedAt: githubv4.DateTime{Time: from.Add(time.Hour)}},
+			{Body: "after", CreatedAt: githubv4.DateTime{Time: to.Add(time.Hour)}},
+		},
+	}
+
+	comments, err := GetCommitCommentsInRange(context.Background(), client, models.ListCommitCommentsOptions{}, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(comments) != 1 || comments[0].Body != "inside" {
+		t.Fatalf("Expected only the comment inside the time range, received %+v", comments)
+	}
+}
+
+func TestCommitCommentsDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	position := int64(12)
+
+	comments := CommitCommentsWrapper{
+		Comments: CommitComments{
+			{
+				Author:    Author{Login: "testUser"},
+				Body:      "This should be behind a feature flag",
+				Path:      "pkg/github/commits.go",
+				Position:  &position,
+				URL:       "https://github.com/grafana/github-datasource/commit/a1b2c3#r1",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+			},
+			{
+				Author:    Author{Login: "otherUser"},
+				Body:      "Looks good",
+				URL:       "https://github.com/grafana/github-datasource/commit/d4e5f6#r2",
+				CreatedAt: githubv4.DateTime{Time: createdAt.Add(time.Hour)},
+			},
+		},
+		Options: models.ListCommitCommentsOptions{BodyMaxLength: 20},
+	}
+	comments.Comments[0].Commit.OID = "a1b2c3"
+	comments.Comments[1].Commit.OID = "d4e5f6"
+
+	if err := testutil.CheckGoldenFramer("commit_comments", comments); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/grafana/github-datasource/pkg/models"
@@ -17,6 +19,68 @@ type Commit struct {
 	CommittedDate githubv4.DateTime
 	Message       githubv4.String
 	Author        GitActor
+
+	// StatusCheckRollup is the combined state of the statuses and check runs of the commit. It is nil if the commit has none
+	StatusCheckRollup *struct {
+		State githubv4.StatusState
+	}
+
+	// Parents are the first two parent commits, which is enough to tell merge commits (that have more than one parent) apart
+	Parents struct {
+		Nodes []CommitParent
+	} `graphql:"parents(first: 2)"`
+
+	// AssociatedPullRequests are the first pull requests that the commit is part of, like the pull request that merged it. Commits that were pushed directly have none
+	AssociatedPullRequests struct {
+		Nodes []CommitPullRequest
+	} `graphql:"associatedPullRequests(first: 5)"`
+}
+
+// CommitParent is a parent of a git commit
+type CommitParent struct {
+	OID string
+}
+
+// CommitPullRequest is a pull request that a git commit is part of
+type CommitPullRequest struct {
+	Number int64
+}
+
+// CommitStatusNone is the status of a commit without any statuses or check runs
+const CommitStatusNone = "NONE"
+
+// Status returns the combined state of the statuses and check runs of the commit (SUCCESS, PENDING, FAILURE, ERROR, or EXPECTED), or CommitStatusNone
+func (c Commit) Status() string {
+	if c.StatusCheckRollup == nil {
+		return CommitStatusNone
+	}
+
+	return string(c.StatusCheckRollup.State)
+}
+
+// ParentSHAs returns the SHAs of the parents of the commit, separated by commas
+func (c Commit) ParentSHAs() string {
+	shas := make([]string, len(c.Parents.Nodes))
+	for i, v := range c.Parents.Nodes {
+		shas[i] = v.OID
+	}
+
+	return strings.Join(shas, ",")
+}
+
+// IsMerge returns true if the commit has more than one parent
+func (c Commit) IsMerge() bool {
+	return len(c.Parents.Nodes) > 1
+}
+
+// PullRequests returns the comma separated numbers of the pull requests that the commit is part of, or an empty string if it is part of none
+func (c Commit) PullRequests() string {
+	numbers := make([]string, len(c.AssociatedPullRequests.Nodes))
+	for i, v := range c.AssociatedPullRequests.Nodes {
+		numbers[i] = strconv.FormatInt(v.Number, 10)
+	}
+
+	return strings.Join(numbers, ",")
 }
 
 // Commits is a slice of git commits
@@ -33,6 +97,10 @@ func (c Commits) Frames() data.Frames {
 		data.NewField("author_company", nil, []string{}),
 		data.NewField("commited_at", nil, []time.Time{}),
 		data.NewField("pushed_at", nil, []time.Time{}),
+		data.NewField("status", nil, []string{}),
+		data.NewField("parent_shas", nil, []string{}),
+		data.NewField("is_merge", nil, []bool{}),
+		data.NewField("pull_requests", nil, []string{}),
 	)
 
 	for _, v := range c {
@@ -44,6 +112,10 @@ func (c Commits) Frames() data.Frames {
 			v.Author.User.Company,
 			v.CommittedDate.Time,
 			v.PushedDate.Time,
+			v.Status(),
+			v.ParentSHAs(),
+			v.IsMerge(),
+			v.PullRequests(),
 		)
 	}
 
@@ -153,3 +225,63 @@ func GetCommitsInRange(ctx context.Context, client Client, opts models.ListCommi
 
 	return commits, nil
 }
+
+// BranchCommit is a git commit along with the branch that it was found in
+type BranchCommit struct {
+	Commit
+	Branch string
+}
+
+// BranchCommits is a list of git commits from multiple branches
+type BranchCommits []BranchCommit
+
+// Frames converts the list of commits to a Grafana DataFrame with the columns of the commits frame and a branch column
+func (c BranchCommits) Frames() data.Frames {
+	var (
+		commits  = make(Commits, len(c))
+		branches = make([]string, len(c))
+	)
+
+	for i, v := range c {
+		commits[i] = v.Commit
+		branches[i] = v.Branch
+	}
+
+	frames := commits.Frames()
+	frames[0].Fields = append(frames[0].Fields, data.NewField("branch", nil, branches))
+
+	return frames
+}
+
+// GetCommitsInBranches lists the commits of every branch in the comma separated Refs option within a time range.
+// A commit that is part of several branches is only returned once, tagged with the first branch in the list that has it.
+func GetCommitsInBranches(ctx context.Context, client Client, opts models.ListCommitsOptions, from time.Time, to time.Time) (BranchCommits, error) {
+	var (
+		commits = BranchCommits{}
+		seen    = map[string]bool{}
+	)
+
+	for _, ref := range strings.Split(opts.Refs, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+
+		opts.Ref = ref
+		branchCommits, err := GetCommitsInRange(ctx, client, opts, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range branchCommits {
+			if seen[v.OID] {
+				continue
+			}
+
+			seen[v.OID] = true
+			commits = append(commits, BranchCommit{Commit: v, Branch: ref})
+		}
+	}
+
+	return commits, nil
+}
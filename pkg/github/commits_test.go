@@ -115,7 +115,61 @@ func TestCommitsDataframe(t *testing.T) {
 		},
 	}
 
+	commits[1].StatusCheckRollup = &struct {
+		State githubv4.StatusState
+	}{State: githubv4.StatusStateFailure}
+
+	commits[0].Parents.Nodes = []CommitParent{{OID: "a1"}}
+	commits[1].Parents.Nodes = []CommitParent{{OID: "b1"}, {OID: "b2"}}
+	commits[1].AssociatedPullRequests.Nodes = []CommitPullRequest{{Number: 12}, {Number: 15}}
+
 	if err := testutil.CheckGoldenFramer("commits", commits); err != nil {
 		t.Fatal(err)
 	}
 }
+
+// branchCommitsClient responds to the commits in range query with the commits of the requested ref
+type branchCommitsClient struct {
+	commits map[string][]Commit
+}
+
+func (c *branchCommitsClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	ref := string(variables["ref"].(githubv4.String))
+	q.(*QueryListCommitsInRange).Repository.Object.Commit.History.Nodes = c.commits[ref]
+	return nil
+}
+
+func TestCommitsInBranchesDataframe(t *testing.T) {
+	committedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		shared = Commit{OID: "1", CommittedDate: githubv4.DateTime{Time: committedAt}, PushedDate: githubv4.DateTime{Time: committedAt}}
+		main   = Commit{OID: "2", CommittedDate: githubv4.DateTime{Time: committedAt.Add(time.Hour)}, PushedDate: githubv4.DateTime{Time: committedAt.Add(time.Hour)}}
+		fix    = Commit{OID: "3", CommittedDate: githubv4.DateTime{Time: committedAt.Add(2 * time.Hour)}, PushedDate: githubv4.DateTime{Time: committedAt.Add(2 * time.Hour)}}
+	)
+
+	client := &branchCommitsClient{
+		commits: map[string][]Commit{
+			"main":        {main, shared},
+			"release-7.0": {fix, shared},
+		},
+	}
+
+	opts := models.ListCommitsOptions{
+		Repository: "grafana",
+		Owner:      "grafana",
+		Refs:       "main, release-7.0,",
+	}
+
+	commits, err := GetCommitsInBranches(context.Background(), client, opts, committedAt, committedAt.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testutil.CheckGoldenFramer("commits_branches", commits); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,3 +2,31 @@ package github
 
 // PageNumberLimit is the limit on the number of pages that will be traversed
 const PageNumberLimit = 2
+
+// RESTPageSize is the number of results requested per page from the REST API, which is the maximum allowed by GitHub
+const RESTPageSize = 100
+
+// PullRequestFilesPageLimit is the limit on the number of pages of changed files that will be traversed for a single pull request.
+// GitHub does not return more than 3000 files for a pull request, which is 30 pages of 100 files.
+const PullRequestFilesPageLimit = 30
+
+// RepoSummaryBatchSize is the number of repositories that are summarized in a single GraphQL request if the BatchSize option is not set
+const RepoSummaryBatchSize = 50
+
+// RepoSummaryMaxBatchSize is the largest number of repositories that are summarized in a single GraphQL request, which keeps the cost of a request well under GitHub's limits
+const RepoSummaryMaxBatchSize = 100
+
+// RepoSummaryConcurrency is the number of GraphQL requests that summarize repositories in parallel if the Concurrency option is not set
+const RepoSummaryConcurrency = 4
+
+// RepoSummaryMaxConcurrency is the largest number of GraphQL requests that summarize repositories in parallel. GitHub's secondary rate limits apply to concurrent requests
+const RepoSummaryMaxConcurrency = 10
+
+// SearchResultLimit is the maximum number of results that GitHub's search API returns for a single search, regardless of pagination
+const SearchResultLimit = 1000
+
+// AutoSplitMaxDepth is the number of times that a time range can be split in half when searching issues with the AutoSplitRange option
+const AutoSplitMaxDepth = 6
+
+// AutoSplitMaxRequests is the number of requests after which a time range is no longer split when searching issues with the AutoSplitRange option
+const AutoSplitMaxRequests = 250
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// ContributionDay is the number of contributions of a user on a single day
+type ContributionDay struct {
+	Date              string
+	ContributionCount int64
+}
+
+// ContributionCalendar is the number of contributions per day of a GitHub user, along with the total in the time range
+type ContributionCalendar struct {
+	TotalContributions int64
+	Weeks              []struct {
+		ContributionDays []ContributionDay
+	}
+}
+
+// Frames converts the contribution calendar to two Grafana DataFrames: the number of contributions per day, and the total number of contributions
+func (c ContributionCalendar) Frames() data.Frames {
+	frame := data.NewFrame(
+		"contribution_calendar",
+		data.NewField("time", nil, []time.Time{}),
+		data.NewField("contributions", nil, []int64{}),
+	)
+
+	for _, week := range c.Weeks {
+		for _, v := range week.ContributionDays {
+			if t, err := time.Parse("2006-01-02", v.Date); err == nil {
+				frame.AppendRow(t, v.ContributionCount)
+			}
+		}
+	}
+
+	total := data.NewFrame(
+		"contribution_total",
+		data.NewField("total", nil, []int64{c.TotalContributions}),
+	)
+
+	return data.Frames{frame, total}
+}
+
+// QueryContributionCalendar is the GraphQL query for the contribution calendar of a user
+// {
+//   user(login: "torkelo") {
+//     contributionsCollection(from: "2020-01-01T00:00:00Z", to: "2020-12-31T23:59:59Z") {
+//       contributionCalendar {
+//         totalContributions
+//         weeks {
+//           contributionDays {
+//             date
+//             contributionCount
+//           }
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryContributionCalendar struct {
+	User struct {
+		ContributionsCollection struct {
+			ContributionCalendar ContributionCalendar
+		} `graphql:"contributionsCollection(from: $from, to: $to)"`
+	} `graphql:"user(login: $login)"`
+}
+
+// GetContributionCalendar returns the contributions per day of a user in the time range. GitHub does not allow time ranges longer than one year
+func GetContributionCalendar(ctx context.Context, client Client, login string, from time.Time, to time.Time) (ContributionCalendar, error) {
+	if to.After(from.AddDate(1, 0, 0)) {
+		return ContributionCalendar{}, errors.WithStack(dserrors.ErrorContributionRangeTooLong)
+	}
+
+	var (
+		variables = map[string]interface{}{
+			"login": githubv4.String(login),
+			"from":  githubv4.DateTime{Time: from},
+			"to":    githubv4.DateTime{Time: to},
+		}
+		q = &QueryContributionCalendar{}
+	)
+
+	if err := client.Query(ctx, q, variables); err != nil {
+		return ContributionCalendar{}, errors.WithStack(err)
+	}
+
+	return q.User.ContributionsCollection.ContributionCalendar, nil
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+func TestGetContributionCalendar(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		from = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("login", "from", "to")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryContributionCalendar{}),
+	)
+
+	t.Run("time ranges up to one year are queried", func(t *testing.T) {
+		if _, err := GetContributionCalendar(ctx, client, "testUser", from, from.AddDate(1, 0, 0)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("time ranges longer than one year are rejected", func(t *testing.T) {
+		_, err := GetContributionCalendar(ctx, client, "testUser", from, from.AddDate(1, 0, 1))
+		if !errors.Is(err, dserrors.ErrorContributionRangeTooLong) {
+			t.Fatalf("Expected ErrorContributionRangeTooLong, received '%v'", err)
+		}
+	})
+}
+
+func TestContributionCalendarDataFrame(t *testing.T) {
+	calendar := ContributionCalendar{
+		TotalContributions: 7,
+		Weeks: []struct {
+			ContributionDays []ContributionDay
+		}{
+			{
+				ContributionDays: []ContributionDay{
+					{Date: "2020-08-23", ContributionCount: 0},
+					{Date: "2020-08-24", ContributionCount: 3},
+				},
+			},
+			{
+				ContributionDays: []ContributionDay{
+					{Date: "2020-08-30", ContributionCount: 4},
+				},
+			},
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("contribution_calendar", calendar); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"strings"
 
 	"github.com/grafana/github-datasource/pkg/models"
 	"github.com/grafana/grafana-plugin-sdk-go/data"
@@ -55,6 +56,28 @@ type User struct {
 	URL     string
 }
 
+// AuthorTypeBot is the GraphQL typename of an Author that is a GitHub App / bot account (like dependabot or renovate)
+const AuthorTypeBot = "Bot"
+
+// Author is the author of an issue or pull request. The author can be any GitHub Actor (User, Bot, Organization, ...),
+// so `__typename` is selected to tell them apart. The `User` fields require a graphql object expansion on `User`,
+// but the login is available for every type of Actor.
+type Author struct {
+	Typename string `graphql:"__typename"`
+	Login    string
+	User     User `graphql:"... on User"`
+}
+
+// IsBot returns true if the author is a bot account
+func (a Author) IsBot() bool {
+	return a.Typename == AuthorTypeBot
+}
+
+// normalizeCompany cleans up the free-text company of a GitHub user, so that values like " @grafana" and "grafana" are the same
+func normalizeCompany(company string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(company), "@"))
+}
+
 // Users is a slice of GitHub users
 type Users []User
 
@@ -84,3 +84,18 @@ func TestContributorsDataFrame(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestNormalizeCompany(t *testing.T) {
+	for input, expect := range map[string]string{
+		"@grafana":       "grafana",
+		" @grafana ":     "grafana",
+		"Grafana Labs ":  "Grafana Labs",
+		"":               "",
+		"@ grafana":      "grafana",
+		"ACME @ Grafana": "ACME @ Grafana",
+	} {
+		if result := normalizeCompany(input); result != expect {
+			t.Errorf("Unexpected result from normalizeCompany(%q). Expected '%s', received '%s'", input, expect, result)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/shurcooL/githubv4"
+)
+
+// cursorClient wraps a Client, starts the pagination of the first paginated query at a given cursor, and keeps track of the cursor of the last page.
+// It is used to resume a large query where a previous one stopped, and to return the cursor to resume from in the frame meta data
+type cursorClient struct {
+	client      Client
+	start       string
+	started     bool
+	endCursor   githubv4.String
+	hasNextPage bool
+}
+
+// newCursorClient wraps the client in a cursorClient that starts at the start cursor. The pagination starts at the first page if start is empty
+func newCursorClient(client Client, start string) *cursorClient {
+	return &cursorClient{client: client, start: start}
+}
+
+// Query sends the query using the wrapped client. The first query that is paginated (that has a cursor variable) starts at the start cursor.
+// Queries without a cursor variable, like looking up the default branch of a repository, are sent as they are
+func (c *cursorClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	if _, ok := variables["cursor"]; !ok {
+		return c.client.Query(ctx, q, variables)
+	}
+
+	if !c.started {
+		c.started = true
+		if c.start != "" {
+			variables["cursor"] = githubv4.String(c.start)
+		}
+	}
+
+	if err := c.client.Query(ctx, q, variables); err != nil {
+		return err
+	}
+
+	if info := findPageInfo(reflect.ValueOf(q)); info != nil {
+		// The end cursor of an empty page is empty, in which case the previous cursor is still the one to resume from
+		if info.EndCursor != "" {
+			c.endCursor = info.EndCursor
+		}
+		c.hasNextPage = info.HasNextPage
+	}
+
+	return nil
+}
+
+func (c *cursorClient) unwrap() Client {
+	return c.client
+}
+
+// CursorMeta is the custom frame meta data of a paginated query. EndCursor can be used as the Cursor option of the next query to continue after the last page
+type CursorMeta struct {
+	EndCursor   string `json:"endCursor,omitempty"`
+	HasNextPage bool   `json:"hasNextPage,omitempty"`
+}
+
+// cursorFramer adds the cursor of the last page to the custom meta data of every frame
+type cursorFramer struct {
+	framer dfutil.Framer
+	meta   CursorMeta
+}
+
+// withCursorInfo adds the cursor of the last page that was requested with the cursorClient to the frames
+func withCursorInfo(framer dfutil.Framer, client *cursorClient) dfutil.Framer {
+	return cursorFramer{
+		framer: framer,
+		meta: CursorMeta{
+			EndCursor:   string(client.endCursor),
+			HasNextPage: client.hasNextPage,
+		},
+	}
+}
+
+// Frames returns the frames of the wrapped Framer with the cursor added to their custom meta data
+func (f cursorFramer) Frames() data.Frames {
+	frames := f.framer.Frames()
+	for _, frame := range frames {
+		if frame.Meta == nil {
+			frame.Meta = &data.FrameMeta{}
+		}
+
+		switch custom := frame.Meta.Custom.(type) {
+		case nil:
+			frame.Meta.Custom = f.meta
+		case DebugMeta:
+			custom.CursorMeta = f.meta
+			frame.Meta.Custom = custom
+		}
+	}
+
+	return frames
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+// pagesClient responds to the issue search with two pages, and records the cursor of every request
+type pagesClient struct {
+	cursors []interface{}
+}
+
+func (c *pagesClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	c.cursors = append(c.cursors, variables["cursor"])
+
+	search := q.(*QuerySearchIssues)
+	if len(c.cursors) == 1 {
+		search.Search.PageInfo = PageInfo{EndCursor: "page2", HasNextPage: true}
+		return nil
+	}
+
+	search.Search.PageInfo = PageInfo{EndCursor: "page3"}
+	return nil
+}
+
+func TestCursorClient(t *testing.T) {
+	testClient := &pagesClient{}
+	cursor := newCursorClient(testClient, "page1")
+
+	variables := map[string]interface{}{
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	for i := 0; i < 2; i++ {
+		q := &QuerySearchIssues{}
+		if err := cursor.Query(context.Background(), q, variables); err != nil {
+			t.Fatal(err)
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	expected := []interface{}{githubv4.String("page1"), githubv4.String("page2")}
+	if !reflect.DeepEqual(testClient.cursors, expected) {
+		t.Fatalf("Unexpected cursors. Expected %v, received %v", expected, testClient.cursors)
+	}
+
+	frames := withCursorInfo(Issues{}, cursor).Frames()
+	meta := CursorMeta{EndCursor: "page3"}
+	if frames[0].Meta == nil || !reflect.DeepEqual(frames[0].Meta.Custom, meta) {
+		t.Fatalf("Expected the end cursor in the frame meta data, received %v", frames[0].Meta)
+	}
+}
+
+func TestCursorClientSkipsUnpaginatedQueries(t *testing.T) {
+	testClient := testutil.NewTestClient(t, nil, nil)
+	cursor := newCursorClient(testClient, "page1")
+
+	variables := map[string]interface{}{
+		"name": githubv4.String("grafana"),
+	}
+	if err := cursor.Query(context.Background(), &QueryRepositoryID{}, variables); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := variables["cursor"]; ok || cursor.started {
+		t.Fatalf("Expected the start cursor to be kept for the first paginated query")
+	}
+}
+
+func TestCursorInfoWithDebugInfo(t *testing.T) {
+	debug := &debugClient{}
+	cursor := &cursorClient{endCursor: "page3", hasNextPage: true}
+
+	frames := withCursorInfo(withDebugInfo(Issues{}, debug), cursor).Frames()
+
+	custom, ok := frames[0].Meta.Custom.(DebugMeta)
+	if !ok {
+		t.Fatalf("Expected the debug meta data, received %v", frames[0].Meta.Custom)
+	}
+
+	if custom.EndCursor != "page3" || !custom.HasNextPage {
+		t.Fatalf("Expected the end cursor in the debug meta data, received %v", custom)
+	}
+}
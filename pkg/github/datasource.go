@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/grafana/github-datasource/pkg/dfutil"
 	"github.com/grafana/github-datasource/pkg/models"
@@ -14,7 +16,15 @@ import (
 
 // Datasource handles requests to GitHub
 type Datasource struct {
-	client *githubv4.Client
+	// client looks up every repository once and reuses it for RepositoryCacheDuration, see repositoryCache
+	client     Client
+	restClient RESTClient
+
+	// workflowDispatchEnabled allows the datasource to trigger workflow runs
+	workflowDispatchEnabled bool
+
+	// bucketCache keeps the counts of the time buckets that are entirely in the past
+	bucketCache *bucketCache
 }
 
 // HandleRepositoriesQuery is the query handler for listing GitHub Repositories
@@ -27,16 +37,133 @@ func (d *Datasource) HandleRepositoriesQuery(ctx context.Context, query *models.
 	return GetAllRepositories(ctx, d.client, opt)
 }
 
+// HandleRepoSummaryQuery is the query handler for summarizing a list of GitHub Repositories
+func (d *Datasource) HandleRepoSummaryQuery(ctx context.Context, query *models.RepoSummaryQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := query.Options
+	if opt.Owner == "" {
+		opt.Owner = query.Owner
+	}
+	if opt.Repositories == "" {
+		opt.Repositories = query.Repository
+	}
+
+	return GetRepoSummaries(ctx, d.client, opt)
+}
+
 // HandleIssuesQuery is the query handler for listing GitHub Issues
 func (d *Datasource) HandleIssuesQuery(ctx context.Context, query *models.IssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
 	opt := models.IssueOptionsWithRepo(query.Options, query.Owner, query.Repository)
-	return GetIssuesInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+	client, debug := newDebugClient(d.client, opt.Debug)
+
+	// The issues are counted in the buckets by the BucketField, so they are searched by it too. Otherwise the issues closed in the time range but created before it would be missing,
+	// and the issues created in the time range would be counted in buckets outside of it
+	if opt.Bucket != models.BucketNone {
+		opt.TimeField = opt.BucketField
+	}
+
+	// The cursor of one search can not be used to resume the searches of the split time ranges, or the separate searches of the opened and closed issues
+	start := opt.Cursor
+	if opt.AutoSplitRange || opt.SplitByState {
+		start = ""
+	}
+	cursor := newCursorClient(client, start)
+
+	loc, err := bucketLocation(opt.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.SplitByState {
+		opened, closed, count, err := searchOpenedAndClosedIssues(ctx, cursor, opt, req.TimeRange.From, req.TimeRange.To)
+		if err != nil {
+			return nil, err
+		}
+
+		return withCursorInfo(withDebugInfo(IssuesWrapper{Issues: opened, Closed: closed, Options: opt, IssueCount: count, Location: loc}, debug), cursor), nil
+	}
+
+	issues, count, err := searchIssues(ctx, cursor, opt, req.TimeRange.From, req.TimeRange.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return withCursorInfo(withDebugInfo(IssuesWrapper{Issues: issues, Options: opt, IssueCount: count, Location: loc}, debug), cursor), nil
+}
+
+// HandleMeanTimeToRestoreQuery is the query handler for computing the mean time to close the GitHub Issues that are incidents
+func (d *Datasource) HandleMeanTimeToRestoreQuery(ctx context.Context, query *models.MeanTimeToRestoreQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.MeanTimeToRestoreOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetMeanTimeToRestore(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandleLeadTimeForChangesQuery is the query handler for computing the mean time from opening to merging GitHub Pull Requests
+func (d *Datasource) HandleLeadTimeForChangesQuery(ctx context.Context, query *models.LeadTimeForChangesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.LeadTimeForChangesOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetLeadTimeForChanges(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandlePinnedIssuesQuery is the query handler for listing the pinned issues of a GitHub repository
+func (d *Datasource) HandlePinnedIssuesQuery(ctx context.Context, query *models.PinnedIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.PinnedIssuesOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetPinnedIssues(ctx, d.client, opt)
+}
+
+// HandleStaleIssuesQuery is the query handler for listing the open GitHub Issues that have not been updated recently
+func (d *Datasource) HandleStaleIssuesQuery(ctx context.Context, query *models.StaleIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.StaleIssueOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetStaleIssues(ctx, d.client, opt, time.Now())
+}
+
+// HandleIssueBurndownQuery is the query handler for counting the GitHub Issues opened and closed over time
+func (d *Datasource) HandleIssueBurndownQuery(ctx context.Context, query *models.IssueBurndownQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.IssueOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return getIssueBurndown(ctx, d.client, d.bucketCache, opt, req.TimeRange.From, req.TimeRange.To, req.Interval, time.Now())
+}
+
+// HandleIssueAssigneesQuery is the query handler for counting the open GitHub Issues per assignee
+func (d *Datasource) HandleIssueAssigneesQuery(ctx context.Context, query *models.IssueAssigneesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.IssueAssigneesOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetIssueAssignees(ctx, d.client, opt)
+}
+
+// HandleIssueFirstResponseQuery is the query handler for measuring the time until the first response to GitHub Issues
+func (d *Datasource) HandleIssueFirstResponseQuery(ctx context.Context, query *models.IssueFirstResponseQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.IssueFirstResponseOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetIssueFirstResponses(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
 }
 
 // HandleCommitsQuery is the query handler for listing GitHub Commits
 func (d *Datasource) HandleCommitsQuery(ctx context.Context, query *models.CommitsQuery, req backend.DataQuery) (dfutil.Framer, error) {
 	opt := models.CommitsOptionsWithRepo(query.Options, query.Owner, query.Repository)
-	return GetCommitsInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+	// The Cursor option is not used when listing the commits of several branches, because a cursor is only valid for the history of one branch
+	if opt.Refs != "" {
+		return GetCommitsInBranches(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+	}
+
+	cursor := newCursorClient(d.client, opt.Cursor)
+	commits, err := GetCommitsInRange(ctx, cursor, opt, req.TimeRange.From, req.TimeRange.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return withCursorInfo(commits, cursor), nil
+}
+
+// HandleCommitCommentsQuery is the query handler for listing the comments on the commits of a repository in the time range
+func (d *Datasource) HandleCommitCommentsQuery(ctx context.Context, query *models.CommitCommentsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.CommitCommentsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	comments, err := GetCommitCommentsInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return CommitCommentsWrapper{Comments: comments, Options: opt}, nil
+}
+
+// HandleCommitAuthorsQuery is the query handler for counting the GitHub Commits per author
+func (d *Datasource) HandleCommitAuthorsQuery(ctx context.Context, query *models.CommitAuthorsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.CommitAuthorsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetCommitAuthorsInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
 }
 
 // HandleTagsQuery is the query handler for listing GitHub Tags
@@ -56,24 +183,88 @@ func (d *Datasource) HandleTagsQuery(ctx context.Context, query *models.TagsQuer
 // HandleReleasesQuery is the query handler for listing GitHub Releases
 func (d *Datasource) HandleReleasesQuery(ctx context.Context, query *models.ReleasesQuery, req backend.DataQuery) (dfutil.Framer, error) {
 	opt := models.ListReleasesOptions{
-		Repository: query.Repository,
-		Owner:      query.Owner,
+		Repository:         query.Repository,
+		Owner:              query.Owner,
+		ExcludeDrafts:      query.Options.ExcludeDrafts,
+		ExcludePrereleases: query.Options.ExcludePrereleases,
+		Bucket:             query.Options.Bucket,
+		Timezone:           query.Options.Timezone,
+
+		IncludeContributors: query.Options.IncludeContributors,
+	}
+
+	loc, err := bucketLocation(opt.Timezone)
+	if err != nil {
+		return nil, err
 	}
 
+	var releases Releases
+
 	if req.TimeRange.From.Unix() <= 0 && req.TimeRange.To.Unix() <= 0 {
-		return GetAllReleases(ctx, d.client, opt)
+		releases, err = GetAllReleases(ctx, d.client, opt)
+	} else {
+		releases, err = GetReleasesInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
 	}
-	return GetReleasesInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ReleasesWrapper{Releases: releases, Options: opt, Location: loc}, nil
 }
 
 // HandlePullRequestsQuery is the query handler for listing GitHub PullRequests
 func (d *Datasource) HandlePullRequestsQuery(ctx context.Context, query *models.PullRequestsQuery, req backend.DataQuery) (dfutil.Framer, error) {
 	opt := models.PullRequestOptionsWithRepo(query.Options, query.Owner, query.Repository)
 
+	client, debug := newDebugClient(d.client, opt.Debug)
+	cursor := newCursorClient(client, opt.Cursor)
+
+	var (
+		pullRequests PullRequests
+		err          error
+	)
+
 	if req.TimeRange.From.Unix() <= 0 && req.TimeRange.To.Unix() <= 0 {
-		return GetAllPullRequests(ctx, d.client, opt)
+		pullRequests, err = GetAllPullRequests(ctx, cursor, opt)
+	} else {
+		pullRequests, err = GetPullRequestsInRange(ctx, cursor, opt, req.TimeRange.From, req.TimeRange.To)
+	}
+
+	if err != nil {
+		return nil, err
 	}
-	return GetPullRequestsInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+
+	return withCursorInfo(withDebugInfo(PullRequestsWrapper{PullRequests: pullRequests, Options: opt}, debug), cursor), nil
+}
+
+// HandlePullRequestFilesQuery is the query handler for listing the files changed in a GitHub Pull Request
+func (d *Datasource) HandlePullRequestFilesQuery(ctx context.Context, query *models.PullRequestFilesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.PullRequestFilesOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetPullRequestFiles(ctx, d.client, opt)
+}
+
+// HandleReviewRequestsQuery is the query handler for listing the open GitHub Pull Requests that are waiting for the review of a user
+func (d *Datasource) HandleReviewRequestsQuery(ctx context.Context, query *models.ReviewRequestsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ReviewRequestsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetReviewRequests(ctx, d.client, opt, time.Now())
+}
+
+// HandleDiscussionsQuery is the query handler for listing GitHub Discussions
+func (d *Datasource) HandleDiscussionsQuery(ctx context.Context, query *models.DiscussionsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.DiscussionsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	discussions, err := GetDiscussionsInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiscussionsWrapper{Discussions: discussions, Options: opt}, nil
+}
+
+// HandleActivityQuery is the query handler for listing the issues and pull requests that were updated most recently in the time range
+func (d *Datasource) HandleActivityQuery(ctx context.Context, query *models.ActivityQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ActivityOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetActivityInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
 }
 
 // HandleContributorsQuery is the query handler for listing GitHub Contributors
@@ -87,26 +278,54 @@ func (d *Datasource) HandleContributorsQuery(ctx context.Context, query *models.
 	return GetAllContributors(ctx, d.client, opt)
 }
 
+// HandleTopContributorsQuery is the query handler for ranking the contributors of a repository by their activity in the time range
+func (d *Datasource) HandleTopContributorsQuery(ctx context.Context, query *models.TopContributorsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.TopContributorsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+	return GetTopContributors(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandleContributionCalendarQuery is the query handler for the contribution calendar of a GitHub user
+func (d *Datasource) HandleContributionCalendarQuery(ctx context.Context, query *models.ContributionCalendarQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	login := query.Options.Login
+	if login == "" {
+		login = query.Owner
+	}
+
+	return GetContributionCalendar(ctx, d.client, login, req.TimeRange.From, req.TimeRange.To)
+}
+
 // HandleLabelsQuery is the query handler for listing GitHub Labels
 func (d *Datasource) HandleLabelsQuery(ctx context.Context, query *models.LabelsQuery, req backend.DataQuery) (dfutil.Framer, error) {
 	opt := models.ListLabelsOptions{
-		Repository: query.Repository,
-		Owner:      query.Owner,
-		Query:      query.Options.Query,
+		Repository:  query.Repository,
+		Owner:       query.Owner,
+		Query:       query.Options.Query,
+		IssueCounts: query.Options.IssueCounts,
+	}
+
+	labels, err := GetAllLabels(ctx, d.client, opt)
+	if err != nil {
+		return nil, err
 	}
 
-	return GetAllLabels(ctx, d.client, opt)
+	return LabelsWrapper{Labels: labels, Options: opt}, nil
 }
 
 // HandleMilestonesQuery is the query handler for listing GitHub Milestones
 func (d *Datasource) HandleMilestonesQuery(ctx context.Context, query *models.MilestonesQuery, req backend.DataQuery) (dfutil.Framer, error) {
 	opt := models.ListMilestonesOptions{
-		Repository: query.Repository,
-		Owner:      query.Owner,
-		Query:      query.Options.Query,
+		Repository:  query.Repository,
+		Owner:       query.Owner,
+		Query:       query.Options.Query,
+		Annotations: query.Options.Annotations,
+	}
+
+	milestones, err := GetAllMilestones(ctx, d.client, opt)
+	if err != nil {
+		return nil, err
 	}
 
-	return GetAllMilestones(ctx, d.client, opt)
+	return MilestonesWrapper{Milestones: milestones, Options: opt}, nil
 }
 
 // HandlePackagesQuery is the query handler for listing GitHub Packages
@@ -116,6 +335,136 @@ func (d *Datasource) HandlePackagesQuery(ctx context.Context, query *models.Pack
 	return GetAllPackages(ctx, d.client, opt)
 }
 
+// HandleProjectsQuery is the query handler for listing the GitHub Projects of an organization
+func (d *Datasource) HandleProjectsQuery(ctx context.Context, query *models.ProjectsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ProjectsOptionsWithOwner(query.Options, query.Owner)
+	return GetAllProjects(ctx, d.client, opt)
+}
+
+// HandleProjectItemsQuery is the query handler for listing the items in a GitHub Project
+func (d *Datasource) HandleProjectItemsQuery(ctx context.Context, query *models.ProjectItemsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ProjectItemsOptionsWithOwner(query.Options, query.Owner)
+	items, err := GetAllProjectItems(ctx, d.client, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return ProjectItemsWrapper{Items: items, Options: opt}, nil
+}
+
+// HandleProjectIssuesQuery is the query handler for listing the issues in a GitHub Project that have a given status
+func (d *Datasource) HandleProjectIssuesQuery(ctx context.Context, query *models.ProjectIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ProjectIssuesOptionsWithOwner(query.Options, query.Owner)
+	issues, err := GetProjectIssuesByStatus(ctx, d.client, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return IssuesWrapper{
+		Issues: issues,
+		Options: models.ListIssuesOptions{
+			IncludePullRequests: opt.IncludePullRequests,
+		},
+	}, nil
+}
+
+// HandleProjectTimeInStatusQuery is the query handler for listing how long the items in a GitHub Project have had their current status
+func (d *Datasource) HandleProjectTimeInStatusQuery(ctx context.Context, query *models.ProjectTimeInStatusQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ProjectTimeInStatusOptionsWithOwner(query.Options, query.Owner)
+	return GetProjectTimeInStatus(ctx, d.client, opt, time.Now())
+}
+
+// HandleRateLimitQuery is the query handler for listing the rate limits of every GitHub API resource
+func (d *Datasource) HandleRateLimitQuery(ctx context.Context, query *models.RateLimitQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return GetRateLimits(ctx, d.restClient)
+}
+
+// HandleSecretScanningAlertsQuery is the query handler for listing GitHub secret scanning alerts
+func (d *Datasource) HandleSecretScanningAlertsQuery(ctx context.Context, query *models.SecretScanningAlertsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.SecretScanningAlertsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	alerts, err := GetAllSecretScanningAlerts(ctx, d.restClient, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return SecretScanningAlertsWrapper{Alerts: alerts, Options: opt}, nil
+}
+
+// HandleAuditLogQuery is the query handler for listing the audit log events of an organization in the time range
+func (d *Datasource) HandleAuditLogQuery(ctx context.Context, query *models.AuditLogQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.AuditLogOptionsWithOwner(query.Options, query.Owner)
+	return GetAuditLogInRange(ctx, d.restClient, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandleDeployKeysQuery is the query handler for listing the deploy keys of a repository
+func (d *Datasource) HandleDeployKeysQuery(ctx context.Context, query *models.DeployKeysQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.DeployKeysOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	return GetAllDeployKeys(ctx, d.restClient, opt)
+}
+
+// HandleWorkflowsQuery is the query handler for listing the GitHub Actions workflows of a repository
+func (d *Datasource) HandleWorkflowsQuery(ctx context.Context, query *models.WorkflowsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.WorkflowsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	return GetAllWorkflows(ctx, d.restClient, opt)
+}
+
+// HandleWebhooksQuery is the query handler for listing the webhooks of a repository or organization
+func (d *Datasource) HandleWebhooksQuery(ctx context.Context, query *models.WebhooksQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.WebhooksOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	return GetAllWebhooks(ctx, d.restClient, opt)
+}
+
+// HandleRulesetsQuery is the query handler for listing the rulesets of a GitHub repository or organization
+func (d *Datasource) HandleRulesetsQuery(ctx context.Context, query *models.RulesetsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.RulesetsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	return GetAllRulesets(ctx, d.restClient, opt)
+}
+
+// HandleDeploymentsQuery is the query handler for listing GitHub Deployments
+func (d *Datasource) HandleDeploymentsQuery(ctx context.Context, query *models.DeploymentsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.DeploymentsOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	if req.TimeRange.From.Unix() <= 0 && req.TimeRange.To.Unix() <= 0 {
+		return GetAllDeployments(ctx, d.client, opt)
+	}
+	return GetDeploymentsInRange(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandleDeploymentFrequencyQuery is the query handler for counting the GitHub Deployments to every environment
+func (d *Datasource) HandleDeploymentFrequencyQuery(ctx context.Context, query *models.DeploymentFrequencyQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.DeploymentFrequencyOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	if req.TimeRange.From.Unix() <= 0 && req.TimeRange.To.Unix() <= 0 {
+		return GetDeploymentFrequency(ctx, d.client, opt, time.Time{}, time.Time{})
+	}
+	return GetDeploymentFrequency(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandleChangeFailureRateQuery is the query handler for computing the share of the GitHub Deployments that failed
+func (d *Datasource) HandleChangeFailureRateQuery(ctx context.Context, query *models.ChangeFailureRateQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	opt := models.ChangeFailureRateOptionsWithRepo(query.Options, query.Owner, query.Repository)
+
+	if req.TimeRange.From.Unix() <= 0 && req.TimeRange.To.Unix() <= 0 {
+		return GetChangeFailureRate(ctx, d.client, opt, time.Time{}, time.Time{})
+	}
+	return GetChangeFailureRate(ctx, d.client, opt, req.TimeRange.From, req.TimeRange.To)
+}
+
+// HandleDeploymentStatusesQuery is the query handler for listing the statuses of a GitHub Deployment
+func (d *Datasource) HandleDeploymentStatusesQuery(ctx context.Context, query *models.DeploymentStatusesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return GetDeploymentStatuses(ctx, d.client, query.Options.DeploymentID)
+}
+
+// ResolveRepository returns the current owner and name of the repository with the node ID
+func (d *Datasource) ResolveRepository(ctx context.Context, id string) (string, string, error) {
+	return GetRepositoryByID(ctx, d.client, id)
+}
+
 // CheckHealth calls frequently used endpoints to determine if the client has sufficient privileges
 func (d *Datasource) CheckHealth(ctx context.Context) error {
 	_, err := GetAllRepositories(ctx, d.client, models.ListRepositoriesOptions{
@@ -130,20 +479,36 @@ func (d *Datasource) CheckHealth(ctx context.Context) error {
 }
 
 // NewDatasource creates a new datasource for handling queries
-func NewDatasource(ctx context.Context, settings models.Settings) *Datasource {
+func NewDatasource(ctx context.Context, settings models.Settings) (*Datasource, error) {
 	src := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: settings.AccessToken},
 	)
 
+	// The oauth2 client sends its requests with the HTTP client in the context, which verifies the certificate of GitHub Enterprise Servers with the configured CA
+	base, err := newTLSTransport(settings.TLSCACert, settings.TLSSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+	if base != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: base})
+	}
+
 	httpClient := oauth2.NewClient(ctx, src)
+	httpClient.Transport = newTransport(httpClient.Transport, userAgent(settings.UserAgent))
 
 	if settings.GithubURL == "" {
 		return &Datasource{
-			client: githubv4.NewClient(httpClient),
-		}
+			client:                  newRepositoryCache(githubv4.NewClient(httpClient)),
+			restClient:              newRESTClient(httpClient, ""),
+			workflowDispatchEnabled: settings.WorkflowDispatchEnabled,
+			bucketCache:             newBucketCache(),
+		}, nil
 	}
 
 	return &Datasource{
-		client: githubv4.NewEnterpriseClient(fmt.Sprintf("%s/api/graphql", settings.GithubURL), httpClient),
-	}
+		client:                  newRepositoryCache(githubv4.NewEnterpriseClient(fmt.Sprintf("%s/api/graphql", settings.GithubURL), httpClient)),
+		restClient:              newRESTClient(httpClient, settings.GithubURL),
+		workflowDispatchEnabled: settings.WorkflowDispatchEnabled,
+		bucketCache:             newBucketCache(),
+	}, nil
 }
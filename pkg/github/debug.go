@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// debugClient wraps a Client, logs the pagination information of every page that is requested, and counts the number of pages.
+// It also collects the GitHub request IDs of the requests.
+// It is used when the Debug option of a query is set, to find out if pagination stopped early
+type debugClient struct {
+	client     Client
+	pages      int
+	requestIDs requestIDs
+}
+
+// newDebugClient wraps the client in a debugClient if debug is true
+func newDebugClient(client Client, debug bool) (Client, *debugClient) {
+	if !debug {
+		return client, nil
+	}
+
+	c := &debugClient{client: client}
+	return c, c
+}
+
+// Query sends the query using the wrapped client and logs the page's end cursor and whether there is a next page
+func (c *debugClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	if err := c.client.Query(withRequestIDs(ctx, &c.requestIDs), q, variables); err != nil {
+		return err
+	}
+
+	c.pages++
+
+	if info := findPageInfo(reflect.ValueOf(q)); info != nil {
+		log.DefaultLogger.Info("github query page", "page", c.pages, "cursor", variables["cursor"], "endCursor", info.EndCursor, "hasNextPage", info.HasNextPage)
+	} else {
+		log.DefaultLogger.Info("github query page", "page", c.pages, "cursor", variables["cursor"])
+	}
+
+	return nil
+}
+
+func (c *debugClient) unwrap() Client {
+	return c.client
+}
+
+// findPageInfo searches the query response for the first PageInfo
+func findPageInfo(v reflect.Value) *PageInfo {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return findPageInfo(v.Elem())
+	case reflect.Struct:
+		if info, ok := v.Interface().(PageInfo); ok {
+			return &info
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if info := findPageInfo(v.Field(i)); info != nil {
+				return info
+			}
+		}
+	}
+
+	return nil
+}
+
+// debugFramer adds the number of pages that were requested, and the GitHub request IDs, to the meta data of every frame
+type debugFramer struct {
+	framer     dfutil.Framer
+	pages      int
+	requestIDs []string
+}
+
+// withDebugInfo adds the debug information to the frames if the query was sent with a debugClient
+func withDebugInfo(framer dfutil.Framer, client *debugClient) dfutil.Framer {
+	if client == nil {
+		return framer
+	}
+
+	return debugFramer{
+		framer:     framer,
+		pages:      client.pages,
+		requestIDs: client.requestIDs.list(),
+	}
+}
+
+// DebugMeta is the custom frame meta data of a query with the Debug option, which is shown in the query inspector.
+// The cursor of the last page is included for the queries that support the Cursor option
+type DebugMeta struct {
+	RequestIDs []string `json:"requestIds"`
+	CursorMeta
+}
+
+// Frames returns the frames of the wrapped Framer with the page count added to their stats, and the request IDs added to their custom meta data
+func (f debugFramer) Frames() data.Frames {
+	frames := f.framer.Frames()
+	for _, frame := range frames {
+		if frame.Meta == nil {
+			frame.Meta = &data.FrameMeta{}
+		}
+
+		frame.Meta.Stats = append(frame.Meta.Stats, data.QueryStat{
+			FieldConfig: data.FieldConfig{
+				DisplayName: "Pages",
+			},
+			Value: float64(f.pages),
+		})
+
+		if frame.Meta.Custom == nil {
+			frame.Meta.Custom = DebugMeta{RequestIDs: f.requestIDs}
+		}
+	}
+
+	return frames
+}
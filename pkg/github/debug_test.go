@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestDebugClient(t *testing.T) {
+	client, debug := newDebugClient(testutil.NewTestClient(t, nil, nil), true)
+
+	for i := 0; i < 3; i++ {
+		if err := client.Query(context.Background(), &QuerySearchIssues{}, map[string]interface{}{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if debug.pages != 3 {
+		t.Fatalf("Unexpected number of pages. Expected 3, received %d", debug.pages)
+	}
+
+	frames := withDebugInfo(Issues{}, debug).Frames()
+	if len(frames[0].Meta.Stats) != 1 || frames[0].Meta.Stats[0].Value != 3 {
+		t.Fatalf("Expected the number of pages in the frame stats, received %v", frames[0].Meta.Stats)
+	}
+}
+
+func TestDebugClientDisabled(t *testing.T) {
+	testClient := testutil.NewTestClient(t, nil, nil)
+
+	client, debug := newDebugClient(testClient, false)
+	if client != testClient || debug != nil {
+		t.Fatalf("Expected the client to not be wrapped")
+	}
+
+	frames := withDebugInfo(Issues{}, debug).Frames()
+	if frames[0].Meta != nil {
+		t.Fatalf("Expected no frame meta data, received %v", frames[0].Meta)
+	}
+}
+
+func TestFindPageInfo(t *testing.T) {
+	q := &QuerySearchIssues{}
+	q.Search.PageInfo = PageInfo{
+		EndCursor:   githubv4.String("Y3Vyc29yOjEwMA=="),
+		HasNextPage: true,
+	}
+
+	info := findPageInfo(reflect.ValueOf(q))
+	if info == nil || !reflect.DeepEqual(*info, q.Search.PageInfo) {
+		t.Fatalf("Unexpected page info: %v", info)
+	}
+}
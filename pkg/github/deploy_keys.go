@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// DeployKey is an SSH key that has access to a single repository
+type DeployKey struct {
+	ID        int64      `json:"id"`
+	Title     string     `json:"title"`
+	ReadOnly  bool       `json:"read_only"`
+	Verified  bool       `json:"verified"`
+	CreatedAt time.Time  `json:"created_at"`
+	LastUsed  *time.Time `json:"last_used"`
+
+	// Repository is the owner and name of the repository that the key belongs to (ex: grafana/grafana). It is not part of the response
+	Repository string `json:"-"`
+}
+
+// DeployKeys is a list of GitHub deploy keys
+type DeployKeys []DeployKey
+
+// Frames converts the list of deploy keys to a Grafana DataFrame
+func (k DeployKeys) Frames() data.Frames {
+	frame := data.NewFrame(
+		"deploy_keys",
+		data.NewField("id", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("repository", nil, []string{}),
+		data.NewField("read_only", nil, []bool{}),
+		data.NewField("verified", nil, []bool{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("last_used", nil, []*time.Time{}),
+	)
+
+	for _, v := range k {
+		var lastUsed *time.Time
+		if v.LastUsed != nil && !v.LastUsed.IsZero() {
+			t := *v.LastUsed
+			lastUsed = &t
+		}
+
+		frame.AppendRow(
+			v.ID,
+			v.Title,
+			v.Repository,
+			v.ReadOnly,
+			v.Verified,
+			v.CreatedAt,
+			lastUsed,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// GetAllDeployKeys lists the deploy keys of a repository using the REST API: /repos/{owner}/{repo}/keys
+// The access token needs admin access to the repository to read its deploy keys.
+func GetAllDeployKeys(ctx context.Context, client RESTClient, opts models.ListDeployKeysOptions) (DeployKeys, error) {
+	var (
+		path   = fmt.Sprintf("/repos/%s/%s/keys", opts.Owner, opts.Repository)
+		params = url.Values{
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+
+		keys = DeployKeys{}
+	)
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		k := DeployKeys{}
+		if err := client.Get(ctx, path, params, &k); err != nil {
+			return nil, deployKeysError(err, opts)
+		}
+
+		for i := range k {
+			k[i].Repository = fmt.Sprintf("%s/%s", opts.Owner, opts.Repository)
+		}
+
+		keys = append(keys, k...)
+
+		if len(k) < RESTPageSize {
+			break
+		}
+	}
+
+	return keys, nil
+}
+
+// deployKeysError replaces the 403 or 404 that GitHub returns when the access token can not read the deploy keys with an error that explains the required permission
+func deployKeysError(err error, opts models.ListDeployKeysOptions) error {
+	var restErr *RESTError
+	if errors.As(err, &restErr) && (restErr.StatusCode == http.StatusForbidden || restErr.StatusCode == http.StatusNotFound) {
+		return errors.Wrapf(dserrors.ErrorDeployKeysForbidden, "%s/%s", opts.Owner, opts.Repository)
+	}
+
+	return errors.WithStack(err)
+}
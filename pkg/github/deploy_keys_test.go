@@ -0,0 +1,69 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+func TestGetAllDeployKeys(t *testing.T) {
+	client := testutil.NewTestRESTClient(t,
+		testutil.GetTestRequestFunction("/repos/grafana/grafana/keys", "per_page", "page"),
+	)
+
+	_, err := GetAllDeployKeys(context.Background(), client, models.ListDeployKeysOptions{Owner: "grafana", Repository: "grafana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAllDeployKeysForbidden(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusNotFound} {
+		client := &errorRESTClient{
+			err: &RESTError{StatusCode: status, Message: http.StatusText(status)},
+		}
+
+		_, err := GetAllDeployKeys(context.Background(), client, models.ListDeployKeysOptions{Owner: "grafana", Repository: "grafana"})
+		if !errors.Is(err, dserrors.ErrorDeployKeysForbidden) {
+			t.Fatalf("Expected error '%s' for status %d, received '%v'", dserrors.ErrorDeployKeysForbidden, status, err)
+		}
+	}
+}
+
+func TestDeployKeysDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lastUsed := createdAt.Add(30 * 24 * time.Hour)
+
+	keys := DeployKeys{
+		{
+			ID:         1,
+			Title:      "deploy@ci",
+			ReadOnly:   true,
+			Verified:   true,
+			CreatedAt:  createdAt,
+			LastUsed:   &lastUsed,
+			Repository: "grafana/grafana",
+		},
+		{
+			ID:         2,
+			Title:      "old release key",
+			Verified:   true,
+			CreatedAt:  createdAt,
+			Repository: "grafana/grafana",
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("deploy_keys", keys); err != nil {
+		t.Fatal(err)
+	}
+}
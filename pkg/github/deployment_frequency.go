@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// EnvironmentDeployments is the number of deployments, and of successful deployments, to an environment.
+// If the deployments are counted in time buckets, the counts of every bucket are set too
+type EnvironmentDeployments struct {
+	Environment string
+	Deployments int64
+	Successful  int64
+
+	Buckets           []time.Time
+	BucketDeployments []int64
+	BucketSuccessful  []int64
+}
+
+// DeploymentFrequency is the number of deployments to every environment of a repository, sorted by environment
+type DeploymentFrequency struct {
+	Environments []EnvironmentDeployments
+	Bucket       models.BucketInterval
+}
+
+// Frames converts the deployment frequency to a Grafana DataFrame with one row per environment.
+// If the deployments are counted in time buckets, there is one frame per environment, named after the environment, with one row per bucket
+func (f DeploymentFrequency) Frames() data.Frames {
+	if f.Bucket != models.BucketNone {
+		frames := make(data.Frames, len(f.Environments))
+		for i, v := range f.Environments {
+			frames[i] = data.NewFrame(
+				v.Environment,
+				data.NewField("time", nil, v.Buckets),
+				data.NewField("deployment_count", nil, v.BucketDeployments),
+				data.NewField("successful_count", nil, v.BucketSuccessful),
+			)
+		}
+
+		return frames
+	}
+
+	frame := data.NewFrame(
+		"deployment_frequency",
+		data.NewField("environment", nil, []string{}),
+		data.NewField("deployment_count", nil, []int64{}),
+		data.NewField("successful_count", nil, []int64{}),
+	)
+
+	for _, v := range f.Environments {
+		frame.AppendRow(
+			v.Environment,
+			v.Deployments,
+			v.Successful,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// deploymentFrequency counts the deployments to every environment. If the bucket interval is set, the deployments are also counted in every bucket of the time range.
+// The buckets are aligned in loc. A zero `from` or `to` is replaced with the time of the oldest or newest deployment
+func deploymentFrequency(deployments Deployments, bucket models.BucketInterval, loc *time.Location, from time.Time, to time.Time) DeploymentFrequency {
+	var (
+		environments = map[string][]Deployment{}
+		names        = []string{}
+	)
+
+	for _, v := range deployments {
+		if _, ok := environments[v.Environment]; !ok {
+			names = append(names, v.Environment)
+		}
+		environments[v.Environment] = append(environments[v.Environment], v)
+	}
+
+	sort.Strings(names)
+
+	var buckets []time.Time
+	if bucket != models.BucketNone {
+		from, to = deploymentsRange(deployments, from, to)
+		buckets = bucketsInRange(from, to, bucket, loc)
+	}
+
+	frequency := DeploymentFrequency{
+		Environments: make([]EnvironmentDeployments, len(names)),
+		Bucket:       bucket,
+	}
+
+	for i, name := range names {
+		var (
+			all        = []time.Time{}
+			successful = []time.Time{}
+		)
+
+		for _, v := range environments[name] {
+			all = append(all, v.CreatedAt.Time)
+			if v.Succeeded() {
+				successful = append(successful, v.CreatedAt.Time)
+			}
+		}
+
+		frequency.Environments[i] = EnvironmentDeployments{
+			Environment: name,
+			Deployments: int64(len(all)),
+			Successful:  int64(len(successful)),
+		}
+
+		if bucket != models.BucketNone {
+			frequency.Environments[i].Buckets = buckets
+			frequency.Environments[i].BucketDeployments = countInBuckets(all, buckets, bucket, loc)
+			frequency.Environments[i].BucketSuccessful = countInBuckets(successful, buckets, bucket, loc)
+		}
+	}
+
+	return frequency
+}
+
+// GetDeploymentFrequency counts the deployments to every environment of a repository that were created within the time range, which is the deployment frequency of the DORA metrics
+func GetDeploymentFrequency(ctx context.Context, client Client, opts models.ListDeploymentFrequencyOptions, from time.Time, to time.Time) (DeploymentFrequency, error) {
+	loc, err := bucketLocation(opts.Timezone)
+	if err != nil {
+		return DeploymentFrequency{}, err
+	}
+
+	deployments, err := GetDeploymentsInRange(ctx, client, models.ListDeploymentsOptions{
+		Owner:        opts.Owner,
+		Repository:   opts.Repository,
+		Environments: opts.Environments,
+	}, from, to)
+	if err != nil {
+		return DeploymentFrequency{}, err
+	}
+
+	return deploymentFrequency(deployments, opts.Bucket, loc, from, to), nil
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetDeploymentFrequency(t *testing.T) {
+	opts := models.ListDeploymentFrequencyOptions{
+		Repository:   "grafana",
+		Owner:        "grafana",
+		Environments: "production",
+	}
+
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("cursor", "name", "owner", "environments"),
+		testutil.GetTestQueryFunction(&QueryListDeployments{}),
+	)
+
+	_, err := GetDeploymentFrequency(context.Background(), client, opts, time.Now().Add(-30*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func frequencyDeployments() Deployments {
+	deployment := func(environment string, state githubv4.DeploymentState, day int) Deployment {
+		return Deployment{
+			Environment: environment,
+			State:       state,
+			CreatedAt:   githubv4.DateTime{Time: time.Date(2020, time.August, day, 12, 0, 0, 0, time.UTC)},
+		}
+	}
+
+	return Deployments{
+		deployment("staging", githubv4.DeploymentStateActive, 12),
+		deployment("production", githubv4.DeploymentStateActive, 11),
+		deployment("staging", githubv4.DeploymentStateFailure, 10),
+		deployment("production", githubv4.DeploymentStateInactive, 4),
+		deployment("production", githubv4.DeploymentStateError, 3),
+	}
+}
+
+func TestDeploymentFrequencyDataframe(t *testing.T) {
+	frequency := deploymentFrequency(frequencyDeployments(), models.BucketNone, time.UTC, time.Time{}, time.Time{})
+
+	if err := testutil.CheckGoldenFramer("deployment_frequency", frequency); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeploymentFrequencyBucketedDataframe(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 16, 0, 0, 0, 0, time.UTC)
+	)
+
+	frequency := deploymentFrequency(frequencyDeployments(), models.BucketWeek, time.UTC, from, to)
+
+	if err := testutil.CheckGoldenFramer("deployment_frequency_buckets", frequency); err != nil {
+		t.Fatal(err)
+	}
+}
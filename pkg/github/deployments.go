@@ -0,0 +1,317 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// DeploymentStatus is a single status update of a deployment, like "PENDING" or "SUCCESS"
+type DeploymentStatus struct {
+	State       githubv4.DeploymentStatusState
+	Description string
+	CreatedAt   githubv4.DateTime
+}
+
+// DeploymentStatuses is the status history of a deployment
+type DeploymentStatuses []DeploymentStatus
+
+// Frames converts the list of deployment statuses to a Grafana DataFrame
+func (d DeploymentStatuses) Frames() data.Frames {
+	frame := data.NewFrame(
+		"deployment_statuses",
+		data.NewField("state", nil, []string{}),
+		data.NewField("description", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+	)
+
+	for _, v := range d {
+		frame.AppendRow(
+			string(v.State),
+			v.Description,
+			v.CreatedAt.Time,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// Deployment is a GitHub deployment of a commit to an environment
+type Deployment struct {
+	ID          string
+	Environment string
+	State       githubv4.DeploymentState
+	Description string
+	CreatedAt   githubv4.DateTime
+	UpdatedAt   githubv4.DateTime
+	Commit      struct {
+		OID string
+	}
+	Ref *struct {
+		Name string
+	}
+	Creator struct {
+		Login string
+	}
+	LatestStatus *DeploymentStatus
+	Statuses     struct {
+		Nodes DeploymentStatuses
+	} `graphql:"statuses(first: 20)"`
+}
+
+// Failed returns true if the deployment is in a failed state
+func (d Deployment) Failed() bool {
+	return d.State == githubv4.DeploymentStateFailure || d.State == githubv4.DeploymentStateError
+}
+
+// Succeeded returns true if the deployment is active, or if it has a successful status. Deployments that were replaced by a newer deployment are inactive, but still succeeded
+func (d Deployment) Succeeded() bool {
+	if d.State == githubv4.DeploymentStateActive {
+		return true
+	}
+
+	for _, v := range d.Statuses.Nodes {
+		if v.State == githubv4.DeploymentStatusStateSuccess {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Duration returns the number of seconds between the creation of the deployment and its first finished (successful, failed, or errored) status.
+// It returns nil if the deployment has not finished yet.
+func (d Deployment) Duration() *float64 {
+	statuses := make(DeploymentStatuses, len(d.Statuses.Nodes))
+	copy(statuses, d.Statuses.Nodes)
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].CreatedAt.Before(statuses[j].CreatedAt.Time)
+	})
+
+	for _, v := range statuses {
+		switch v.State {
+		case githubv4.DeploymentStatusStateSuccess, githubv4.DeploymentStatusStateFailure, githubv4.DeploymentStatusStateError:
+			s := v.CreatedAt.UTC().Sub(d.CreatedAt.UTC()).Seconds()
+			return &s
+		}
+	}
+
+	return nil
+}
+
+// Deployments is a list of GitHub deployments
+type Deployments []Deployment
+
+// Frames converts the list of deployments to a Grafana DataFrame
+func (d Deployments) Frames() data.Frames {
+	duration := data.NewField("duration", nil, []*float64{})
+	duration.Config = &data.FieldConfig{
+		Unit: "s", // The values are in seconds
+	}
+
+	frame := data.NewFrame(
+		"deployments",
+		data.NewField("id", nil, []string{}),
+		data.NewField("environment", nil, []string{}),
+		data.NewField("state", nil, []string{}),
+		data.NewField("description", nil, []string{}),
+		data.NewField("ref", nil, []string{}),
+		data.NewField("commit", nil, []string{}),
+		data.NewField("creator", nil, []string{}),
+		data.NewField("latest_status", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+		duration,
+	)
+
+	for _, v := range d {
+		var (
+			ref          string
+			latestStatus string
+		)
+
+		if v.Ref != nil {
+			ref = v.Ref.Name
+		}
+
+		if v.LatestStatus != nil {
+			latestStatus = string(v.LatestStatus.State)
+		}
+
+		frame.AppendRow(
+			v.ID,
+			v.Environment,
+			string(v.State),
+			v.Description,
+			ref,
+			v.Commit.OID,
+			v.Creator.Login,
+			latestStatus,
+			v.CreatedAt.Time,
+			v.UpdatedAt.Time,
+			v.Duration(),
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QueryListDeployments is the GraphQL query for listing the deployments in a repository, newest first
+// {
+//   repository(name: "grafana", owner: "grafana") {
+//     deployments(first: 100, environments: ["production"], orderBy: {field: CREATED_AT, direction: DESC}) {
+//       nodes {
+//         id
+//         environment
+//         state
+//         statuses(first: 20) {
+//           nodes {
+//             state
+//             createdAt
+//           }
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryListDeployments struct {
+	Repository struct {
+		Deployments struct {
+			Nodes    []Deployment
+			PageInfo PageInfo
+		} `graphql:"deployments(first: 100, after: $cursor, environments: $environments, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// GetAllDeployments lists every deployment in a repository
+func GetAllDeployments(ctx context.Context, client Client, opts models.ListDeploymentsOptions) (Deployments, error) {
+	return GetDeploymentsInRange(ctx, client, opts, time.Time{}, time.Time{})
+}
+
+// GetDeploymentsInRange lists the deployments in a repository that were created within the time range.
+// Because the deployments are sorted by their creation time, pagination stops at the first deployment created before the time range.
+// A zero `from` or `to` leaves that end of the range open.
+func GetDeploymentsInRange(ctx context.Context, client Client, opts models.ListDeploymentsOptions, from time.Time, to time.Time) (Deployments, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor":       (*githubv4.String)(nil),
+			"name":         githubv4.String(opts.Repository),
+			"owner":        githubv4.String(opts.Owner),
+			"environments": environmentsVariable(opts.Environments),
+		}
+
+		deployments = Deployments{}
+	)
+
+	for {
+		q := &QueryListDeployments{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		done := false
+		for _, v := range q.Repository.Deployments.Nodes {
+			if !from.IsZero() && v.CreatedAt.Before(from) {
+				done = true
+				break
+			}
+
+			if !to.IsZero() && v.CreatedAt.After(to) {
+				continue
+			}
+
+			if opts.FailedOnly && !v.Failed() {
+				continue
+			}
+
+			deployments = append(deployments, v)
+		}
+
+		if done || !q.Repository.Deployments.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Repository.Deployments.PageInfo.EndCursor
+	}
+
+	return deployments, nil
+}
+
+// deploymentsRange replaces a zero `from` or `to` with the creation time of the oldest or newest deployment
+func deploymentsRange(deployments Deployments, from time.Time, to time.Time) (time.Time, time.Time) {
+	openFrom, openTo := from.IsZero(), to.IsZero()
+	for _, v := range deployments {
+		if openFrom && (from.IsZero() || v.CreatedAt.Before(from)) {
+			from = v.CreatedAt.Time
+		}
+		if openTo && (to.IsZero() || v.CreatedAt.After(to)) {
+			to = v.CreatedAt.Time
+		}
+	}
+
+	return from, to
+}
+
+// environmentsVariable converts the comma separated list of environments to the GraphQL variable. Null is used to return deployments to every environment.
+func environmentsVariable(environments string) *[]githubv4.String {
+	if strings.TrimSpace(environments) == "" {
+		return nil
+	}
+
+	s := strings.Split(environments, ",")
+	v := make([]githubv4.String, len(s))
+	for i, env := range s {
+		v[i] = githubv4.String(strings.TrimSpace(env))
+	}
+
+	return &v
+}
+
+// QueryListDeploymentStatuses is the GraphQL query for listing the complete status history of a single deployment
+type QueryListDeploymentStatuses struct {
+	Node struct {
+		Deployment struct {
+			Statuses struct {
+				Nodes    DeploymentStatuses
+				PageInfo PageInfo
+			} `graphql:"statuses(first: 100, after: $cursor)"`
+		} `graphql:"... on Deployment"`
+	} `graphql:"node(id: $id)"`
+}
+
+// GetDeploymentStatuses lists every status of a deployment, oldest first
+func GetDeploymentStatuses(ctx context.Context, client Client, deploymentID string) (DeploymentStatuses, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"id":     githubv4.ID(deploymentID),
+		}
+
+		statuses = DeploymentStatuses{}
+	)
+
+	for {
+		q := &QueryListDeploymentStatuses{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		statuses = append(statuses, q.Node.Deployment.Statuses.Nodes...)
+
+		if !q.Node.Deployment.Statuses.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Node.Deployment.Statuses.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].CreatedAt.Before(statuses[j].CreatedAt.Time)
+	})
+
+	return statuses, nil
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetAllDeployments(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListDeploymentsOptions{
+			Repository:   "grafana",
+			Owner:        "grafana",
+			Environments: "production, staging",
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("cursor", "name", "owner", "environments")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryListDeployments{}),
+	)
+
+	_, err := GetAllDeployments(ctx, client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetDeploymentStatuses(t *testing.T) {
+	ctx := context.Background()
+
+	testVariables := testutil.GetTestVariablesFunction("cursor", "id")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryListDeploymentStatuses{}),
+	)
+
+	_, err := GetDeploymentStatuses(ctx, client, "DE_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func deploymentStatus(state githubv4.DeploymentStatusState, description string, createdAt time.Time) DeploymentStatus {
+	return DeploymentStatus{
+		State:       state,
+		Description: description,
+		CreatedAt:   githubv4.DateTime{Time: createdAt},
+	}
+}
+
+func TestDeploymentsDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	success := Deployment{
+		ID:          "DE_1",
+		Environment: "production",
+		State:       githubv4.DeploymentStateActive,
+		Description: "Deploy v7.1.5",
+		CreatedAt:   githubv4.DateTime{Time: createdAt},
+		UpdatedAt:   githubv4.DateTime{Time: createdAt.Add(5 * time.Minute)},
+		Ref: &struct {
+			Name string
+		}{
+			Name: "v7.1.5",
+		},
+	}
+	success.Commit.OID = "2cd2d9e59f6a5d8f1ad7c7d1bcbc6d3b5676ae4e"
+	success.Creator.Login = "testUser"
+	success.Statuses.Nodes = DeploymentStatuses{
+		deploymentStatus(githubv4.DeploymentStatusStateSuccess, "Deployed", createdAt.Add(5*time.Minute)),
+		deploymentStatus(githubv4.DeploymentStatusStateInProgress, "Deploying", createdAt.Add(time.Minute)),
+	}
+	success.LatestStatus = &success.Statuses.Nodes[0]
+
+	failure := Deployment{
+		ID:          "DE_2",
+		Environment: "staging",
+		State:       githubv4.DeploymentStateFailure,
+		CreatedAt:   githubv4.DateTime{Time: createdAt.Add(time.Hour)},
+		UpdatedAt:   githubv4.DateTime{Time: createdAt.Add(time.Hour + 90*time.Second)},
+	}
+	failure.Commit.OID = "b1ef6a5d8f1ad7c7d1bcbc6d3b5676ae4e2cd2d9"
+	failure.Creator.Login = "testUser2"
+	failure.Statuses.Nodes = DeploymentStatuses{
+		deploymentStatus(githubv4.DeploymentStatusStateFailure, "Health check failed", createdAt.Add(time.Hour+90*time.Second)),
+	}
+	failure.LatestStatus = &failure.Statuses.Nodes[0]
+
+	pending := Deployment{
+		ID:          "DE_3",
+		Environment: "production",
+		State:       githubv4.DeploymentStatePending,
+		CreatedAt:   githubv4.DateTime{Time: createdAt.Add(2 * time.Hour)},
+		UpdatedAt:   githubv4.DateTime{Time: createdAt.Add(2 * time.Hour)},
+	}
+	pending.Commit.OID = "d1bcbc6d3b5676ae4e2cd2d9b1ef6a5d8f1ad7c7"
+	pending.Creator.Login = "testUser"
+
+	deployments := Deployments{success, failure, pending}
+
+	if err := testutil.CheckGoldenFramer("deployments", deployments); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeploymentStatusesDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := DeploymentStatuses{
+		deploymentStatus(githubv4.DeploymentStatusStateQueued, "", createdAt),
+		deploymentStatus(githubv4.DeploymentStatusStateInProgress, "Deploying", createdAt.Add(time.Minute)),
+		deploymentStatus(githubv4.DeploymentStatusStateSuccess, "Deployed", createdAt.Add(5*time.Minute)),
+	}
+
+	if err := testutil.CheckGoldenFramer("deployment_statuses", statuses); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeploymentFailed(t *testing.T) {
+	for state, want := range map[githubv4.DeploymentState]bool{
+		githubv4.DeploymentStateActive:   false,
+		githubv4.DeploymentStatePending:  false,
+		githubv4.DeploymentStateFailure:  true,
+		githubv4.DeploymentStateError:    true,
+		githubv4.DeploymentStateInactive: false,
+	} {
+		if got := (Deployment{State: state}).Failed(); got != want {
+			t.Errorf("Deployment{State: %s}.Failed() = %t, want %t", state, got, want)
+		}
+	}
+}
+
+func TestDeploymentSucceeded(t *testing.T) {
+	replaced := Deployment{State: githubv4.DeploymentStateInactive}
+	replaced.Statuses.Nodes = DeploymentStatuses{
+		{State: githubv4.DeploymentStatusStateInactive},
+		{State: githubv4.DeploymentStatusStateSuccess},
+	}
+
+	for name, tc := range map[string]struct {
+		deployment Deployment
+		want       bool
+	}{
+		"active":   {Deployment{State: githubv4.DeploymentStateActive}, true},
+		"replaced": {replaced, true},
+		"failed":   {Deployment{State: githubv4.DeploymentStateFailure}, false},
+		"pending":  {Deployment{State: githubv4.DeploymentStatePending}, false},
+	} {
+		if got := tc.deployment.Succeeded(); got != tc.want {
+			t.Errorf("%s deployment: Succeeded() = %t, want %t", name, got, tc.want)
+		}
+	}
+}
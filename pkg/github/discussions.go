@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// Discussion is a GitHub discussion
+type Discussion struct {
+	Number   int64
+	Title    string
+	URL      string
+	Author   Author
+	Category struct {
+		Name string
+		Slug string
+	}
+	IsAnswered bool
+	Comments   struct {
+		TotalCount int64
+	}
+	CreatedAt githubv4.DateTime
+	UpdatedAt githubv4.DateTime
+
+	Reactable
+}
+
+// Discussions is a list of GitHub discussions
+type Discussions []Discussion
+
+// Frames converts the list of discussions to a Grafana DataFrame
+func (d Discussions) Frames() data.Frames {
+	return DiscussionsWrapper{Discussions: d}.Frames()
+}
+
+// DiscussionsWrapper is a list of GitHub discussions along with the query options that change how they are converted to a data frame
+type DiscussionsWrapper struct {
+	Discussions Discussions
+	Options     models.ListDiscussionsOptions
+}
+
+// Frames converts the list of discussions to a Grafana DataFrame
+func (w DiscussionsWrapper) Frames() data.Frames {
+	frame := data.NewFrame(
+		"discussions",
+		data.NewField("number", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("author_login", nil, []string{}),
+		data.NewField("category", nil, []string{}),
+		data.NewField("is_answered", nil, []bool{}),
+		data.NewField("comments", nil, []int64{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+	)
+
+	if w.Options.IncludeReactions {
+		frame.Fields = append(frame.Fields, reactionFields()...)
+	}
+
+	for _, v := range w.Discussions {
+		values := []interface{}{
+			v.Number,
+			v.Title,
+			v.URL,
+			v.Author.Login,
+			v.Category.Name,
+			v.IsAnswered,
+			v.Comments.TotalCount,
+			v.CreatedAt.Time,
+			v.UpdatedAt.Time,
+		}
+
+		if w.Options.IncludeReactions {
+			values = append(values, v.ReactionValues()...)
+		}
+
+		frame.AppendRow(values...)
+	}
+
+	return data.Frames{frame}
+}
+
+// QuerySearchDiscussions is the GraphQL query for searching the discussions of a repository
+// {
+//   search(query: "repo:grafana/grafana category:q-a is:unanswered created:2020-08-19..2020-08-20", type: DISCUSSION, first: 100) {
+//     nodes {
+//       ... on Discussion {
+//         number
+//         title
+//         isAnswered
+//         category {
+//           name
+//         }
+//       }
+//     }
+//   }
+// }
+type QuerySearchDiscussions struct {
+	Search struct {
+		Nodes []struct {
+			Discussion Discussion `graphql:"... on Discussion"`
+		}
+		PageInfo PageInfo
+	} `graphql:"search(query: $query, type: DISCUSSION, first: 100, after: $cursor)"`
+}
+
+// discussionsQuery builds the search for the discussions of a repository that were created in the time range
+func discussionsQuery(opts models.ListDiscussionsOptions, from time.Time, to time.Time) string {
+	search := []string{
+		fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository),
+	}
+
+	if category := strings.TrimSpace(opts.Category); category != "" {
+		search = append(search, fmt.Sprintf(`category:"%s"`, category))
+	}
+
+	if opts.AnsweredOnly != nil {
+		if *opts.AnsweredOnly {
+			search = append(search, "is:answered")
+		} else {
+			search = append(search, "is:unanswered")
+		}
+	}
+
+	if opts.Query != nil {
+		search = append(search, *opts.Query)
+	}
+
+	search = append(search, fmt.Sprintf("created:%s..%s", from.Format(time.RFC3339), to.Format(time.RFC3339)))
+
+	return strings.Join(search, " ")
+}
+
+// GetDiscussionsInRange lists the discussions of a repository that were created in the time range
+func GetDiscussionsInRange(ctx context.Context, client Client, opts models.ListDiscussionsOptions, from time.Time, to time.Time) (Discussions, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor":           (*githubv4.String)(nil),
+			"query":            githubv4.String(discussionsQuery(opts, from, to)),
+			"includeReactions": githubv4.Boolean(opts.IncludeReactions),
+		}
+
+		discussions = Discussions{}
+	)
+
+	for {
+		q := &QuerySearchDiscussions{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Search.Nodes {
+			discussions = append(discussions, v.Discussion)
+		}
+
+		if !q.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	return discussions, nil
+}
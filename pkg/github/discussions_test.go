@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetDiscussions(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("query", "cursor", "includeReactions"),
+		testutil.GetTestQueryFunction(&QuerySearchDiscussions{}),
+	)
+
+	_, err := GetDiscussionsInRange(context.Background(), client, models.ListDiscussionsOptions{Owner: "grafana", Repository: "grafana"}, time.Now().Add(-7*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscussionsQuery(t *testing.T) {
+	var (
+		from = time.Date(2020, 8, 19, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("every discussion should be searched if no filter is set", func(t *testing.T) {
+		var (
+			result = discussionsQuery(models.ListDiscussionsOptions{Owner: "grafana", Repository: "grafana"}, from, to)
+			expect = "repo:grafana/grafana created:2020-08-19T00:00:00Z..2020-08-20T00:00:00Z"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from discussionsQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("the category and answered status should be added as qualifiers", func(t *testing.T) {
+		answered := false
+		var (
+			result = discussionsQuery(models.ListDiscussionsOptions{Owner: "grafana", Repository: "grafana", Category: "Q&A", AnsweredOnly: &answered}, from, to)
+			expect = `repo:grafana/grafana category:"Q&A" is:unanswered created:2020-08-19T00:00:00Z..2020-08-20T00:00:00Z`
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from discussionsQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("answered discussions should be searched if AnsweredOnly is true", func(t *testing.T) {
+		answered := true
+		var (
+			result = discussionsQuery(models.ListDiscussionsOptions{Owner: "grafana", Repository: "grafana", AnsweredOnly: &answered}, from, to)
+			expect = "repo:grafana/grafana is:answered created:2020-08-19T00:00:00Z..2020-08-20T00:00:00Z"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from discussionsQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+}
+
+func TestDiscussionsDataframe(t *testing.T) {
+	created, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := Discussion{
+		Number:     4,
+		Title:      "How do I query discussions?",
+		URL:        "https://github.com/grafana/github-datasource/discussions/4",
+		Author:     Author{Login: "testUser"},
+		IsAnswered: true,
+		CreatedAt:  githubv4.DateTime{Time: created},
+		UpdatedAt:  githubv4.DateTime{Time: created.Add(2 * time.Hour)},
+	}
+	first.Category.Name = "Q&A"
+	first.Category.Slug = "q-a"
+	first.Comments.TotalCount = 3
+
+	second := Discussion{
+		Number:    5,
+		Title:     "Dashboard ideas",
+		URL:       "https://github.com/grafana/github-datasource/discussions/5",
+		Author:    Author{Login: "otherUser"},
+		CreatedAt: githubv4.DateTime{Time: created.Add(24 * time.Hour)},
+		UpdatedAt: githubv4.DateTime{Time: created.Add(24 * time.Hour)},
+	}
+	second.Category.Name = "Ideas"
+	second.Category.Slug = "ideas"
+
+	if err := testutil.CheckGoldenFramer("discussions", Discussions{first, second}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscussionsWithReactionsDataframe(t *testing.T) {
+	created, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	discussion := Discussion{
+		Number:    4,
+		Title:     "How do I query discussions?",
+		URL:       "https://github.com/grafana/github-datasource/discussions/4",
+		Author:    Author{Login: "testUser"},
+		CreatedAt: githubv4.DateTime{Time: created},
+		UpdatedAt: githubv4.DateTime{Time: created},
+	}
+	discussion.Category.Name = "Q&A"
+	discussion.Reactions.TotalCount = 4
+	discussion.ReactionGroups = []ReactionGroup{
+		{Content: githubv4.ReactionContentEyes},
+		{Content: githubv4.ReactionContentThumbsDown},
+	}
+	discussion.ReactionGroups[0].Reactors.TotalCount = 3
+	discussion.ReactionGroups[1].Reactors.TotalCount = 1
+
+	discussions := DiscussionsWrapper{
+		Discussions: Discussions{discussion},
+		Options:     models.ListDiscussionsOptions{IncludeReactions: true},
+	}
+
+	if err := testutil.CheckGoldenFramer("discussions_reactions", discussions); err != nil {
+		t.Fatal(err)
+	}
+}
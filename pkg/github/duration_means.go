@@ -0,0 +1,109 @@
+package github
+
+import (
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// timedDuration is a duration in seconds along with the time that decides which bucket it is counted in (ex: when an issue was closed)
+type timedDuration struct {
+	Time    time.Time
+	Seconds float64
+}
+
+// DurationMeans is the number of durations in a time range and their mean in seconds, and optionally the same in every time bucket of the range
+type DurationMeans struct {
+	Count int64
+	Mean  *float64
+
+	Bucket       models.BucketInterval
+	Buckets      []time.Time
+	BucketCounts []int64
+	BucketMeans  []*float64
+}
+
+// meanDuration returns the mean of the durations, or nil if there are none
+func meanDuration(total float64, n int64) *float64 {
+	if n == 0 {
+		return nil
+	}
+
+	mean := total / float64(n)
+	return &mean
+}
+
+// meanDurations computes the mean of the durations. If the bucket interval is set, the durations are also grouped into the buckets of the time range, which are aligned in loc
+func meanDurations(durations []timedDuration, bucket models.BucketInterval, loc *time.Location, from time.Time, to time.Time) DurationMeans {
+	means := DurationMeans{
+		Count:  int64(len(durations)),
+		Bucket: bucket,
+	}
+
+	var total float64
+	for _, v := range durations {
+		total += v.Seconds
+	}
+	means.Mean = meanDuration(total, means.Count)
+
+	if bucket == models.BucketNone {
+		return means
+	}
+
+	means.Buckets = bucketsInRange(from, to, bucket, loc)
+
+	var (
+		index  = make(map[time.Time]int, len(means.Buckets))
+		totals = make([]float64, len(means.Buckets))
+	)
+
+	for i, v := range means.Buckets {
+		index[v] = i
+	}
+
+	means.BucketCounts = make([]int64, len(means.Buckets))
+	for _, v := range durations {
+		if i, ok := index[bucketStart(v.Time, bucket, loc)]; ok {
+			totals[i] += v.Seconds
+			means.BucketCounts[i]++
+		}
+	}
+
+	means.BucketMeans = make([]*float64, len(means.Buckets))
+	for i := range means.Buckets {
+		means.BucketMeans[i] = meanDuration(totals[i], means.BucketCounts[i])
+	}
+
+	return means
+}
+
+// frame converts the means to a Grafana DataFrame with a single row, or with one row per bucket if the durations are grouped into time buckets.
+// The mean is null if there are no durations
+func (m DurationMeans) frame(name string, countName string, meanName string) *data.Frame {
+	mean := data.NewField(meanName, nil, []*float64{})
+	mean.Config = &data.FieldConfig{
+		Unit: "s", // The values are in seconds
+	}
+
+	if m.Bucket != models.BucketNone {
+		for _, v := range m.BucketMeans {
+			mean.Append(v)
+		}
+
+		return data.NewFrame(
+			name,
+			data.NewField("time", nil, m.Buckets),
+			data.NewField(countName, nil, m.BucketCounts),
+			mean,
+		)
+	}
+
+	mean.Append(m.Mean)
+
+	return data.NewFrame(
+		name,
+		data.NewField(countName, nil, []int64{m.Count}),
+		mean,
+	)
+}
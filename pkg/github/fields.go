@@ -0,0 +1,54 @@
+package github
+
+import (
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// selectFields returns the data frame fields with the given names, in their original order. Names that do not match a field are ignored
+func selectFields(fields []*data.Field, names []string) []*data.Field {
+	selected := []*data.Field{}
+	for _, v := range fields {
+		if contains(names, v.Name) {
+			selected = append(selected, v)
+		}
+	}
+
+	return selected
+}
+
+// contains returns true if the value is in the list
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsAny returns true if any of the values are in the list
+func containsAny(list []string, values []string) bool {
+	for _, v := range values {
+		if contains(list, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// truncateBody cuts off the description of an issue or pull request after maxLength characters (DefaultBodyMaxLength if it is not set), and marks it with an ellipsis
+func truncateBody(body string, maxLength int64) string {
+	if maxLength <= 0 {
+		maxLength = models.DefaultBodyMaxLength
+	}
+
+	runes := []rune(body)
+	if int64(len(runes)) <= maxLength {
+		return body
+	}
+
+	return string(runes[:maxLength]) + "…"
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"reflect"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// graphQLErrorMessages returns the messages of the `errors` array of a GraphQL response.
+// The GraphQL client still decodes the data of a response that has errors, so the query can be partially filled when this returns messages.
+// It returns nil for every other error, like network errors or non-200 responses, where no data was returned.
+func graphQLErrorMessages(err error) []string {
+	// The GraphQL client's error type is not exported, but it is a slice of structs with a Message
+	v := reflect.ValueOf(errors.Cause(err))
+	if !v.IsValid() || v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	field, ok := v.Type().Elem().FieldByName("Message")
+	if !ok || field.Type.Kind() != reflect.String {
+		return nil
+	}
+
+	messages := make([]string, v.Len())
+	for i := range messages {
+		messages[i] = v.Index(i).FieldByIndex(field.Index).String()
+	}
+
+	return messages
+}
+
+// partialErrorsMeta returns the frame meta data with a warning for every GraphQL error of a partially successful query, or nil if there are no errors
+func partialErrorsMeta(messages []string) *data.FrameMeta {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	notices := make([]data.Notice, len(messages))
+	for i, v := range messages {
+		notices[i] = data.Notice{
+			Severity: data.NoticeSeverityWarning,
+			Text:     v,
+		}
+	}
+
+	return &data.FrameMeta{
+		Notices: notices,
+	}
+}
@@ -0,0 +1,134 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// UnassignedAssignee is the assignee that the open issues without an assignee are counted for
+const UnassignedAssignee = "unassigned"
+
+// IssueAssignee is a GitHub user along with the number of open issues assigned to them
+type IssueAssignee struct {
+	Login      string
+	OpenIssues int64
+}
+
+// IssueAssignees is a list of assignees, sorted by their number of open issues
+type IssueAssignees []IssueAssignee
+
+// Frames converts the list of assignees to a Grafana DataFrame with one row per assignee
+func (a IssueAssignees) Frames() data.Frames {
+	frame := data.NewFrame(
+		"issue_assignees",
+		data.NewField("assignee", nil, []string{}),
+		data.NewField("open_issues", nil, []int64{}),
+	)
+
+	for _, v := range a {
+		frame.AppendRow(
+			v.Login,
+			v.OpenIssues,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QuerySearchIssueAssignees is the GraphQL query for the assignees of the issues that match a search
+// {
+//   search(query: "is:issue is:open repo:grafana/grafana", type: ISSUE, first: 100) {
+//     nodes {
+//       ... on Issue {
+//         assignees(first: 20) {
+//           nodes {
+//             login
+//           }
+//         }
+//       }
+//     }
+//   }
+// }
+type QuerySearchIssueAssignees struct {
+	Search struct {
+		Nodes []struct {
+			Issue struct {
+				Assignees struct {
+					Nodes []struct {
+						Login string
+					}
+				} `graphql:"assignees(first: 20)"`
+			} `graphql:"... on Issue"`
+		}
+		PageInfo PageInfo
+	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
+}
+
+// GetIssueAssignees counts the open issues of every assignee in a repository.
+// Issues with multiple assignees are counted for each of them, and issues without an assignee are counted for UnassignedAssignee.
+func GetIssueAssignees(ctx context.Context, client Client, opts models.ListIssueAssigneesOptions) (IssueAssignees, error) {
+	search := []string{
+		"is:issue",
+		"is:open",
+		issueSearchScope(models.ListIssuesOptions{Owner: opts.Owner, Repository: opts.Repository}),
+	}
+
+	if opts.Query != nil {
+		search = append(search, *opts.Query)
+	}
+
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"query":  githubv4.String(strings.Join(search, " ")),
+		}
+
+		assignees = IssueAssignees{}
+		index     = map[string]int{}
+	)
+
+	count := func(login string) {
+		i, ok := index[login]
+		if !ok {
+			i = len(assignees)
+			index[login] = i
+			assignees = append(assignees, IssueAssignee{Login: login})
+		}
+		assignees[i].OpenIssues++
+	}
+
+	for {
+		q := &QuerySearchIssueAssignees{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Search.Nodes {
+			if len(v.Issue.Assignees.Nodes) == 0 {
+				count(UnassignedAssignee)
+				continue
+			}
+
+			for _, assignee := range v.Issue.Assignees.Nodes {
+				count(assignee.Login)
+			}
+		}
+
+		if !q.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(assignees, func(i, j int) bool {
+		return assignees[i].OpenIssues > assignees[j].OpenIssues
+	})
+
+	return assignees, nil
+}
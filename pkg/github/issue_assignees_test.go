@@ -0,0 +1,78 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+// issueAssigneesClient responds to the issue assignees search with a single page of issues with the given assignees
+type issueAssigneesClient struct {
+	assignees [][]string
+}
+
+func (c *issueAssigneesClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	search := &q.(*QuerySearchIssueAssignees).Search
+	search.Nodes = make([]struct {
+		Issue struct {
+			Assignees struct {
+				Nodes []struct {
+					Login string
+				}
+			} `graphql:"assignees(first: 20)"`
+		} `graphql:"... on Issue"`
+	}, len(c.assignees))
+
+	for i, logins := range c.assignees {
+		for _, login := range logins {
+			search.Nodes[i].Issue.Assignees.Nodes = append(search.Nodes[i].Issue.Assignees.Nodes, struct{ Login string }{Login: login})
+		}
+	}
+
+	return nil
+}
+
+func TestGetIssueAssignees(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListIssueAssigneesOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("query", "cursor")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QuerySearchIssueAssignees{}),
+	)
+
+	if _, err := GetIssueAssignees(ctx, client, opts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIssueAssigneesDataframe(t *testing.T) {
+	client := &issueAssigneesClient{
+		assignees: [][]string{
+			{"firstUser"},
+			{},
+			{"firstUser", "secondUser"},
+			{"thirdUser"},
+			{"secondUser"},
+			{"firstUser"},
+		},
+	}
+
+	assignees, err := GetIssueAssignees(context.Background(), client, models.ListIssueAssigneesOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testutil.CheckGoldenFramer("issue_assignees", assignees); err != nil {
+		t.Fatal(err)
+	}
+}
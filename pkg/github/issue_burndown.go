@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// IssueBurndown is the number of issues that were opened and closed in every time bucket of a time range
+type IssueBurndown struct {
+	Buckets []time.Time
+	Opened  []int64
+	Closed  []int64
+}
+
+// Frames converts the issue burndown to a Grafana DataFrame. The `net_open` column is the running total of opened minus closed issues since the start of the time range
+func (b IssueBurndown) Frames() data.Frames {
+	netOpen := make([]int64, len(b.Buckets))
+
+	var total int64
+	for i := range b.Buckets {
+		total += b.Opened[i] - b.Closed[i]
+		netOpen[i] = total
+	}
+
+	frame := data.NewFrame(
+		"issue_burndown",
+		data.NewField("time", nil, b.Buckets),
+		data.NewField("opened", nil, b.Opened),
+		data.NewField("closed", nil, b.Closed),
+		data.NewField("net_open", nil, netOpen),
+	)
+
+	return data.Frames{frame}
+}
+
+// GetIssueBurndown counts the issues that were opened and closed in every bucket of the time range.
+// The issues that were created and the issues that were closed in the time range are retrieved with two separate searches.
+// The Bucket option sets the size of the buckets; if it is not set, the bucket size is picked using the query interval.
+func GetIssueBurndown(ctx context.Context, client Client, opts models.ListIssuesOptions, from time.Time, to time.Time, interval time.Duration) (IssueBurndown, error) {
+	return getIssueBurndown(ctx, client, nil, opts, from, to, interval, time.Now())
+}
+
+// getIssueBurndown counts the issues like GetIssueBurndown, but reads the counts of the buckets that are entirely in the past from the cache.
+// Only the runs of buckets that are not cached are searched, and the buckets that are entirely before now are cached afterwards
+func getIssueBurndown(ctx context.Context, client Client, cache *bucketCache, opts models.ListIssuesOptions, from time.Time, to time.Time, interval time.Duration, now time.Time) (IssueBurndown, error) {
+	bucket := opts.Bucket
+	if bucket == models.BucketNone {
+		bucket = intervalBucket(interval)
+	}
+
+	loc, err := bucketLocation(opts.Timezone)
+	if err != nil {
+		return IssueBurndown{}, err
+	}
+
+	var (
+		buckets = bucketsInRange(from, to, bucket, loc)
+		opened  = make([]int64, len(buckets))
+		closed  = make([]int64, len(buckets))
+		missing = []int{}
+	)
+
+	query, cacheable := bucketCacheQuery(opts)
+	if !cacheable {
+		cache = nil
+	}
+
+	for i, v := range buckets {
+		if counts, ok := cache.get(query, bucket, v, now); ok {
+			opened[i], closed[i] = counts[0], counts[1]
+			continue
+		}
+		missing = append(missing, i)
+	}
+
+	for _, run := range bucketRuns(missing) {
+		var (
+			first   = run[0]
+			last    = run[len(run)-1]
+			runFrom = buckets[first]
+			runTo   = nextBucket(buckets[last], bucket).Add(-time.Second)
+		)
+
+		if runFrom.Before(from) {
+			runFrom = from
+		}
+		if runTo.After(to) {
+			runTo = to
+		}
+
+		runOpened, runClosed, err := countIssuesInBuckets(ctx, client, opts, runFrom, runTo, buckets[first:last+1], bucket, loc)
+		if err != nil {
+			return IssueBurndown{}, err
+		}
+
+		for i := range run {
+			opened[first+i], closed[first+i] = runOpened[i], runClosed[i]
+
+			if bucketIsFinal(buckets[first+i], bucket, from, to, now) {
+				cache.set(query, bucket, buckets[first+i], []int64{runOpened[i], runClosed[i]}, now)
+			}
+		}
+	}
+
+	return IssueBurndown{
+		Buckets: buckets,
+		Opened:  opened,
+		Closed:  closed,
+	}, nil
+}
+
+// countIssuesInBuckets searches the issues that were created and the issues that were closed in the time range, and counts them in the buckets
+func countIssuesInBuckets(ctx context.Context, client Client, opts models.ListIssuesOptions, from time.Time, to time.Time, buckets []time.Time, bucket models.BucketInterval, loc *time.Location) ([]int64, []int64, error) {
+	created, closed, _, err := searchOpenedAndClosedIssues(ctx, client, opts, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdAt := make([]time.Time, len(created))
+	for i, v := range created {
+		createdAt[i] = v.CreatedAt.Time
+	}
+
+	closedAt := make([]time.Time, len(closed))
+	for i, v := range closed {
+		closedAt[i] = v.ClosedAt.Time
+	}
+
+	return countInBuckets(createdAt, buckets, bucket, loc), countInBuckets(closedAt, buckets, bucket, loc), nil
+}
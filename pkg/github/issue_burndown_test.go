@@ -0,0 +1,124 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetIssueBurndown(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListIssuesOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+		}
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC)
+
+		searches = []string{}
+	)
+
+	testVariables := func(t *testing.T, m map[string]interface{}) {
+		testutil.EnsureKeysAreSet(t, m, "query", "cursor")
+		searches = append(searches, string(m["query"].(githubv4.String)))
+	}
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QuerySearchIssues{}),
+	)
+
+	burndown, err := GetIssueBurndown(ctx, client, opts, from, to, 7*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(searches) != 2 || !strings.Contains(searches[0], "created:") || !strings.Contains(searches[1], "closed:") {
+		t.Errorf("expected a search for created and a search for closed issues, received %v", searches)
+	}
+
+	// 2020-07-27 (the Monday before the start of the time range) until 2020-08-31
+	if len(burndown.Buckets) != 6 {
+		t.Errorf("expected 6 weekly buckets, received %d", len(burndown.Buckets))
+	}
+}
+
+func TestIssueBurndownDataframe(t *testing.T) {
+	start := time.Date(2020, time.August, 24, 0, 0, 0, 0, time.UTC)
+
+	burndown := IssueBurndown{
+		Buckets: []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2), start.AddDate(0, 0, 3)},
+		Opened:  []int64{5, 2, 0, 1},
+		Closed:  []int64{1, 3, 2, 0},
+	}
+
+	if err := testutil.CheckGoldenFramer("issue_burndown", burndown); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetIssueBurndownCached(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListIssuesOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+			Bucket:     models.BucketWeek,
+		}
+		from  = time.Date(2020, time.August, 3, 0, 0, 0, 0, time.UTC)
+		to    = time.Date(2020, time.August, 31, 23, 59, 59, 0, time.UTC)
+		now   = time.Date(2020, time.August, 26, 12, 0, 0, 0, time.UTC)
+		cache = newBucketCache()
+
+		searches = []string{}
+	)
+
+	testVariables := func(t *testing.T, m map[string]interface{}) {
+		searches = append(searches, string(m["query"].(githubv4.String)))
+	}
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QuerySearchIssues{}),
+	)
+
+	if _, err := getIssueBurndown(ctx, client, cache, opts, from, to, 0, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(searches) != 2 {
+		t.Fatalf("expected every bucket to be searched at once on the first request, received %v", searches)
+	}
+
+	// The weeks starting on 2020-08-03, 2020-08-10, and 2020-08-17 ended before now, so they were cached by the first request.
+	// The counts of the first week are changed to check that they are read from the cache
+	query, _ := bucketCacheQuery(opts)
+	cache.set(query, models.BucketWeek, from, []int64{4, 1}, now)
+
+	searches = []string{}
+	burndown, err := getIssueBurndown(ctx, client, cache, opts, from, to, 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(searches) != 2 || !strings.Contains(searches[0], "created:2020-08-24T00:00:00Z..2020-08-31T23:59:59Z") {
+		t.Fatalf("expected only the weeks that have not ended to be searched, received %v", searches)
+	}
+
+	if len(burndown.Buckets) != 5 || burndown.Opened[0] != 4 || burndown.Closed[0] != 1 {
+		t.Fatalf("expected the counts of the first week to be read from the cache, received %+v", burndown)
+	}
+}
+
+func TestBucketRuns(t *testing.T) {
+	runs := bucketRuns([]int{0, 3, 4, 5, 7})
+	if len(runs) != 3 || len(runs[0]) != 1 || len(runs[1]) != 3 || runs[2][0] != 7 {
+		t.Fatalf("unexpected runs %v", runs)
+	}
+}
@@ -0,0 +1,249 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// IssueComment is a comment on a GitHub issue
+type IssueComment struct {
+	Author    Author
+	CreatedAt githubv4.DateTime
+}
+
+// IssueWithComments is a GitHub issue along with its first comments, which is used to find the first response to the issue
+type IssueWithComments struct {
+	Number    int64
+	Title     string
+	CreatedAt githubv4.DateTime
+	Author    Author
+	Comments  struct {
+		Nodes []IssueComment
+	} `graphql:"comments(first: 100)"`
+}
+
+// IssueFirstResponse is the first response to an issue. The response fields are nil if nobody has responded yet
+type IssueFirstResponse struct {
+	Number          int64
+	Title           string
+	CreatedAt       time.Time
+	FirstResponseAt *time.Time
+	Responder       string
+}
+
+// IssueFirstResponses is a list of the first responses to GitHub issues
+type IssueFirstResponses []IssueFirstResponse
+
+// Frames converts the list of first responses to a Grafana DataFrame
+func (r IssueFirstResponses) Frames() data.Frames {
+	seconds := data.NewField("first_response_seconds", nil, []*float64{})
+	seconds.Config = &data.FieldConfig{
+		Unit: "s", // The values are in seconds
+	}
+
+	frame := data.NewFrame(
+		"issue_first_response",
+		data.NewField("number", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("first_response_at", nil, []*time.Time{}),
+		data.NewField("responder", nil, []string{}),
+		seconds,
+	)
+
+	for _, v := range r {
+		var s *float64
+		if v.FirstResponseAt != nil {
+			d := v.FirstResponseAt.UTC().Sub(v.CreatedAt.UTC()).Seconds()
+			s = &d
+		}
+
+		frame.AppendRow(
+			v.Number,
+			v.Title,
+			v.CreatedAt,
+			v.FirstResponseAt,
+			v.Responder,
+			s,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// firstResponse finds the first comment on the issue by somebody other than the author of the issue. Comments by bots are not responses.
+// If the list of maintainers is not empty, only comments by maintainers are counted
+func (i IssueWithComments) firstResponse(maintainers map[string]bool) IssueFirstResponse {
+	response := IssueFirstResponse{
+		Number:    i.Number,
+		Title:     i.Title,
+		CreatedAt: i.CreatedAt.Time,
+	}
+
+	for _, v := range i.Comments.Nodes {
+		login := v.Author.Login
+		if v.Author.IsBot() || login == "" || strings.EqualFold(login, i.Author.Login) {
+			continue
+		}
+
+		if len(maintainers) > 0 && !maintainers[strings.ToLower(login)] {
+			continue
+		}
+
+		t := v.CreatedAt.Time
+		response.FirstResponseAt = &t
+		response.Responder = login
+		break
+	}
+
+	return response
+}
+
+// QuerySearchIssuesWithComments is the GraphQL query for searching issues along with their first comments
+// {
+//   search(query: "is:issue repo:grafana/grafana created:2020-08-19..*", type: ISSUE, first: 100) {
+//     nodes {
+//       ... on Issue {
+//         number
+//         comments(first: 100) {
+//           nodes {
+//             author {
+//               login
+//             }
+//             createdAt
+//           }
+//         }
+//       }
+//     }
+//   }
+// }
+type QuerySearchIssuesWithComments struct {
+	Search struct {
+		Nodes []struct {
+			Issue IssueWithComments `graphql:"... on Issue"`
+		}
+		PageInfo PageInfo
+	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
+}
+
+// QueryListTeamMembers is the GraphQL query for listing the members of a team in an organization
+type QueryListTeamMembers struct {
+	Organization struct {
+		Team struct {
+			Members struct {
+				Nodes []struct {
+					Login string
+				}
+				PageInfo PageInfo
+			} `graphql:"members(first: 100, after: $cursor)"`
+		} `graphql:"team(slug: $team)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+// GetTeamMembers lists the logins of every member of a team in an organization
+func GetTeamMembers(ctx context.Context, client Client, owner string, team string) ([]string, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"owner":  githubv4.String(owner),
+			"team":   githubv4.String(team),
+		}
+
+		members = []string{}
+	)
+
+	for {
+		q := &QueryListTeamMembers{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Organization.Team.Members.Nodes {
+			members = append(members, v.Login)
+		}
+
+		if !q.Organization.Team.Members.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Organization.Team.Members.PageInfo.EndCursor
+	}
+
+	return members, nil
+}
+
+// getMaintainers returns the set of lowercase logins whose comments count as a response, from the list of maintainers and the members of the team.
+// An empty set means that a comment by anybody other than the author counts
+func getMaintainers(ctx context.Context, client Client, opts models.ListIssueFirstResponseOptions) (map[string]bool, error) {
+	maintainers := map[string]bool{}
+
+	for _, v := range strings.Split(opts.Maintainers, ",") {
+		if login := strings.TrimSpace(v); login != "" {
+			maintainers[strings.ToLower(login)] = true
+		}
+	}
+
+	if opts.Team != "" {
+		members, err := GetTeamMembers(ctx, client, opts.Owner, opts.Team)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range members {
+			maintainers[strings.ToLower(v)] = true
+		}
+	}
+
+	return maintainers, nil
+}
+
+// GetIssueFirstResponses finds the first response to every issue that was created in the time range
+func GetIssueFirstResponses(ctx context.Context, client Client, opts models.ListIssueFirstResponseOptions, from time.Time, to time.Time) (IssueFirstResponses, error) {
+	maintainers, err := getMaintainers(ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	search := []string{
+		"is:issue",
+		fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository),
+		fmt.Sprintf("created:%s..%s", from.Format(time.RFC3339), to.Format(time.RFC3339)),
+	}
+
+	if opts.Query != nil {
+		search = append(search, *opts.Query)
+	}
+
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"query":  githubv4.String(strings.Join(search, " ")),
+		}
+
+		responses = IssueFirstResponses{}
+	)
+
+	for {
+		q := &QuerySearchIssuesWithComments{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Search.Nodes {
+			responses = append(responses, v.Issue.firstResponse(maintainers))
+		}
+
+		if !q.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetIssueFirstResponses(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListIssueFirstResponseOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("query", "cursor")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QuerySearchIssuesWithComments{}),
+	)
+
+	_, err := GetIssueFirstResponses(ctx, client, opts, time.Now().Add(-30*24*time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetTeamMembers(t *testing.T) {
+	ctx := context.Background()
+
+	testVariables := testutil.GetTestVariablesFunction("cursor", "owner", "team")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryListTeamMembers{}),
+	)
+
+	_, err := GetTeamMembers(ctx, client, "grafana", "grafana-frontend")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func issueComment(login string, typename string, createdAt time.Time) IssueComment {
+	return IssueComment{
+		Author: Author{
+			Typename: typename,
+			Login:    login,
+		},
+		CreatedAt: githubv4.DateTime{Time: createdAt},
+	}
+}
+
+func TestIssueFirstResponsesDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue := IssueWithComments{
+		Number:    1,
+		Title:     "Issue #1",
+		CreatedAt: githubv4.DateTime{Time: createdAt},
+		Author: Author{
+			Typename: "User",
+			Login:    "reporter",
+		},
+	}
+	issue.Comments.Nodes = []IssueComment{
+		issueComment("reporter", "User", createdAt.Add(10*time.Minute)),
+		issueComment("stale", AuthorTypeBot, createdAt.Add(20*time.Minute)),
+		issueComment("someoneElse", "User", createdAt.Add(time.Hour)),
+		issueComment("maintainer", "User", createdAt.Add(3*time.Hour)),
+	}
+
+	unanswered := IssueWithComments{
+		Number:    2,
+		Title:     "Issue #2",
+		CreatedAt: githubv4.DateTime{Time: createdAt},
+		Author: Author{
+			Typename: "User",
+			Login:    "reporter",
+		},
+	}
+	unanswered.Comments.Nodes = []IssueComment{
+		issueComment("reporter", "User", createdAt.Add(10*time.Minute)),
+	}
+
+	responses := IssueFirstResponses{
+		issue.firstResponse(map[string]bool{}),
+		issue.firstResponse(map[string]bool{"maintainer": true}),
+		unanswered.firstResponse(map[string]bool{}),
+	}
+
+	if err := testutil.CheckGoldenFramer("issue_first_response", responses); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -14,15 +14,41 @@ import (
 
 // Issue represents a GitHub issue in a repository
 type Issue struct {
-	Number    int64
-	Title     string
-	ClosedAt  githubv4.DateTime
-	CreatedAt githubv4.DateTime
-	Closed    bool
-	Author    struct {
-		User `graphql:"... on User"`
-	}
+	Number     int64
+	Title      string
+	ClosedAt   githubv4.DateTime
+	CreatedAt  githubv4.DateTime
+	UpdatedAt  githubv4.DateTime
+	Closed     bool
+	Author     Author
 	Repository Repository
+
+	// Body is the plain text description of the issue. It is only part of the query if the includeBody variable is true
+	Body string `graphql:"bodyText @include(if: $includeBody)"`
+
+	// ReopenedEvents is the number of times the issue was reopened. It is only part of the query if the includeReopenedCount variable is true
+	ReopenedEvents struct {
+		TotalCount int64
+	} `graphql:"reopenedEvents: timelineItems(itemTypes: [REOPENED_EVENT]) @include(if: $includeReopenedCount)"`
+
+	// Typename is either "Issue" or "PullRequest", because pull requests are returned as issues when they are included in issue searches
+	Typename string `graphql:"__typename"`
+}
+
+// IsPullRequest returns true if the issue is a pull request
+func (i Issue) IsPullRequest() bool {
+	return i.Typename == "PullRequest"
+}
+
+// TimeToClose returns the number of seconds between the creation of the issue and the last time it was closed.
+// It returns nil if the issue has not been closed.
+func (i Issue) TimeToClose() *float64 {
+	if i.ClosedAt.IsZero() {
+		return nil
+	}
+
+	s := i.ClosedAt.UTC().Sub(i.CreatedAt.UTC()).Seconds()
+	return &s
 }
 
 // Issues is a slice of GitHub issues
@@ -30,40 +56,240 @@ type Issues []Issue
 
 // Frames converts the list of issues to a Grafana DataFrame
 func (c Issues) Frames() data.Frames {
-	frame := data.NewFrame(
-		"issues",
+	return IssuesWrapper{Issues: c}.Frames()
+}
+
+// IssuesWrapper is a list of GitHub issues along with the query options that change how they are converted to a data frame
+type IssuesWrapper struct {
+	Issues  Issues
+	Options models.ListIssuesOptions
+
+	// IssueCount is the number of issues that matched the search, or the largest number of issues that matched a single search when the time range was split.
+	// If it is larger than SearchResultLimit, a notice is added to the frame because some issues are missing
+	IssueCount int64
+
+	// Closed are the issues that were closed in the time range, for the "closed" frame of the SplitByState option.
+	// They are a separate search from Issues, because an issue that was closed in the time range can have been created before it
+	Closed Issues
+
+	// Location is the time zone that the buckets are aligned in (see the Timezone option). UTC is used if it is nil
+	Location *time.Location
+}
+
+// Frames converts the list of issues to a Grafana DataFrame using the query options
+func (w IssuesWrapper) Frames() data.Frames {
+	if w.Options.SplitByState {
+		return w.stateFrames()
+	}
+
+	if w.Options.Bucket != models.BucketNone {
+		return w.bucketFrames()
+	}
+
+	fields := []*data.Field{
 		data.NewField("title", nil, []string{}),
 		data.NewField("author", nil, []string{}),
 		data.NewField("author_company", nil, []string{}),
+		data.NewField("author_type", nil, []string{}),
 		data.NewField("repo", nil, []string{}),
 		data.NewField("number", nil, []int64{}),
 		data.NewField("closed", nil, []bool{}),
 		data.NewField("created_at", nil, []time.Time{}),
 		data.NewField("closed_at", nil, []*time.Time{}),
-	)
+	}
+
+	if w.Options.NormalizeCompany {
+		fields = append(fields, data.NewField("author_company_raw", nil, []string{}))
+	}
+
+	if w.Options.IncludePullRequests {
+		fields = append(fields, data.NewField("is_pull_request", nil, []bool{}))
+	}
+
+	if w.Options.IncludeBody {
+		fields = append(fields, data.NewField("body", nil, []string{}))
+	}
+
+	if w.Options.IncludeReopenedCount {
+		fields = append(fields, data.NewField("reopened_count", nil, []int64{}))
+	}
 
-	for _, v := range c {
+	frame := data.NewFrame("issues", fields...)
+	frame.Meta = w.searchLimitMeta()
+
+	for _, v := range w.Issues {
 		var closedAt *time.Time
 		if !v.ClosedAt.Time.IsZero() {
 			t := v.ClosedAt.Time
 			closedAt = &t
 		}
 
-		frame.AppendRow(
+		company := v.Author.User.Company
+		if w.Options.NormalizeCompany {
+			company = normalizeCompany(company)
+		}
+
+		values := []interface{}{
 			v.Title,
-			v.Author.User.Login,
-			v.Author.User.Company,
+			v.Author.Login,
+			company,
+			v.Author.Typename,
 			v.Repository.NameWithOwner,
 			v.Number,
 			v.Closed,
 			v.CreatedAt.Time,
 			closedAt,
-		)
+		}
+
+		if w.Options.NormalizeCompany {
+			values = append(values, v.Author.User.Company)
+		}
+
+		if w.Options.IncludePullRequests {
+			values = append(values, v.IsPullRequest())
+		}
+
+		if w.Options.IncludeBody {
+			values = append(values, truncateBody(v.Body, w.Options.BodyMaxLength))
+		}
+
+		if w.Options.IncludeReopenedCount {
+			values = append(values, v.ReopenedEvents.TotalCount)
+		}
+
+		frame.AppendRow(values...)
+	}
+
+	return data.Frames{frame}
+}
+
+// bucketFrames converts the list of issues to a time series with the number of issues in every bucket
+func (w IssuesWrapper) bucketFrames() data.Frames {
+	times := []time.Time{}
+	for _, v := range w.Issues {
+		t := v.CreatedAt.Time
+		if w.Options.BucketField == models.IssuetClosedAt {
+			t = v.ClosedAt.Time
+		}
+
+		// Open issues are not counted when bucketing by the time that they were closed
+		if t.IsZero() {
+			continue
+		}
+
+		times = append(times, t)
 	}
 
+	buckets, counts := bucketCounts(times, w.Options.Bucket, w.Location)
+
+	frame := data.NewFrame(
+		"issues",
+		data.NewField("time", nil, buckets),
+		data.NewField("count", nil, counts),
+	)
+	frame.Meta = w.searchLimitMeta()
+
 	return data.Frames{frame}
 }
 
+// stateFrames converts the list of issues to an "opened" frame that uses the time the issues were created, and the list of closed issues to a "closed" frame that uses the time they were closed.
+// The time is the first column of both frames so that they can be shown as two series of the same panel
+func (w IssuesWrapper) stateFrames() data.Frames {
+	var (
+		openedTimes = make([]time.Time, len(w.Issues))
+		closed      = Issues{}
+		closedTimes = []time.Time{}
+	)
+
+	for i, v := range w.Issues {
+		openedTimes[i] = v.CreatedAt.Time
+	}
+
+	for _, v := range w.Closed {
+		// An issue that was reopened since the search is not closed anymore
+		if !v.ClosedAt.Time.IsZero() {
+			closed = append(closed, v)
+			closedTimes = append(closedTimes, v.ClosedAt.Time)
+		}
+	}
+
+	return data.Frames{
+		w.stateFrame("opened", w.Issues, openedTimes),
+		w.stateFrame("closed", closed, closedTimes),
+	}
+}
+
+// stateFrame returns a frame with one row per issue at the time in times, or with the number of issues per bucket if the Bucket option is set
+func (w IssuesWrapper) stateFrame(name string, issues Issues, times []time.Time) *data.Frame {
+	if w.Options.Bucket != models.BucketNone {
+		buckets, counts := bucketCounts(times, w.Options.Bucket, w.Location)
+
+		frame := data.NewFrame(
+			name,
+			data.NewField("time", nil, buckets),
+			data.NewField("count", nil, counts),
+		)
+		frame.Meta = w.searchLimitMeta()
+
+		return frame
+	}
+
+	frame := data.NewFrame(
+		name,
+		data.NewField("time", nil, []time.Time{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("author", nil, []string{}),
+		data.NewField("repo", nil, []string{}),
+		data.NewField("number", nil, []int64{}),
+	)
+	frame.Meta = w.searchLimitMeta()
+
+	for i, v := range issues {
+		frame.AppendRow(
+			times[i],
+			v.Title,
+			v.Author.Login,
+			v.Repository.NameWithOwner,
+			v.Number,
+		)
+	}
+
+	return frame
+}
+
+// searchLimitMeta returns the frame meta data with a warning if the search matched more issues than GitHub returns, or nil
+func (w IssuesWrapper) searchLimitMeta() *data.FrameMeta {
+	return noticesMeta(searchLimitNotice(w.IssueCount, "issues", "Narrow the time range or enable splitting the time range to see every issue"))
+}
+
+// searchLimitNotice returns a warning, which ends with the advice, if a search matched more than SearchResultLimit items (ex: issues), or nil
+func searchLimitNotice(count int64, items string, advice string) *data.Notice {
+	if count <= SearchResultLimit {
+		return nil
+	}
+
+	return &data.Notice{
+		Severity: data.NoticeSeverityWarning,
+		Text:     fmt.Sprintf("%d %s matched a single search, but GitHub's search API returns at most %d. %s", count, items, SearchResultLimit, advice),
+	}
+}
+
+// noticesMeta returns the frame meta data with the notices that are not nil, or nil if they are all nil
+func noticesMeta(notices ...*data.Notice) *data.FrameMeta {
+	meta := &data.FrameMeta{}
+	for _, v := range notices {
+		if v != nil {
+			meta.Notices = append(meta.Notices, *v)
+		}
+	}
+
+	if len(meta.Notices) == 0 {
+		return nil
+	}
+
+	return meta
+}
+
 // QuerySearchIssues is the object representation of the graphql query for retrieving a paginated list of issues using the search query
 // {
 //   search(query: "is:issue repo:grafana/grafana opened:2020-08-19..*", type: ISSUE, first: 100) {
@@ -77,51 +303,222 @@ func (c Issues) Frames() data.Frames {
 type QuerySearchIssues struct {
 	Search struct {
 		Nodes []struct {
-			Issue Issue `graphql:"... on Issue"`
+			Issue       Issue `graphql:"... on Issue"`
+			PullRequest Issue `graphql:"... on PullRequest"`
 		}
-		PageInfo PageInfo
+		PageInfo   PageInfo
+		IssueCount int64
 	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
 }
 
 // GetIssuesInRange lists issues in a project given a time range.
+// If the Repository is not set, the issues in every repository of the organization (the Org option, or the Owner) are listed.
+// GitHub's search API does not return more than 1000 results for a single search, so a narrow time range should be used for large organizations.
 func GetIssuesInRange(ctx context.Context, client Client, opts models.ListIssuesOptions, from time.Time, to time.Time) (Issues, error) {
+	issues, _, err := searchIssues(ctx, client, opts, from, to)
+	return issues, err
+}
+
+// searchIssues lists the issues in the time range like GetIssuesInRange.
+// It also returns the number of issues that match the search, which is more than the number of issues returned if the search exceeds SearchResultLimit.
+// If the AutoSplitRange option is set, the time range is split into smaller time ranges until every search is below the limit
+func searchIssues(ctx context.Context, client Client, opts models.ListIssuesOptions, from time.Time, to time.Time) (Issues, int64, error) {
+	if opts.AutoSplitRange {
+		splitter := &issueRangeSplitter{
+			client: client,
+			opts:   opts,
+		}
+		return splitter.search(ctx, from, to, 0)
+	}
+
+	return searchIssuesInRange(ctx, client, opts, from, to, false)
+}
+
+// searchOpenedAndClosedIssues searches the issues that were created in the time range and the issues that were closed in the time range separately, so that the issues that were created before the time range but closed in it are not missing.
+// The count is the larger of the counts of the two searches
+func searchOpenedAndClosedIssues(ctx context.Context, client Client, opts models.ListIssuesOptions, from time.Time, to time.Time) (Issues, Issues, int64, error) {
+	createdOpts := opts
+	createdOpts.TimeField = models.IssueCreatedAt
+
+	opened, openedCount, err := searchIssues(ctx, client, createdOpts, from, to)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	closedOpts := opts
+	closedOpts.TimeField = models.IssuetClosedAt
+
+	closed, closedCount, err := searchIssues(ctx, client, closedOpts, from, to)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if closedCount > openedCount {
+		return opened, closed, closedCount, nil
+	}
+
+	return opened, closed, openedCount, nil
+}
+
+// searchIssuesInRange sends a single (paginated) search for the issues in the time range.
+// If stopAboveLimit is true, it stops after the first page if the search matches more than SearchResultLimit issues
+func searchIssuesInRange(ctx context.Context, client Client, opts models.ListIssuesOptions, from time.Time, to time.Time, stopAboveLimit bool) (Issues, int64, error) {
 	search := []string{
-		"is:issue",
-		fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository),
+		issueSearchScope(opts),
 		fmt.Sprintf("%s:%s..%s", opts.TimeField.String(), from.Format(time.RFC3339), to.Format(time.RFC3339)),
 	}
 
+	// Without the "is:issue" qualifier, the search returns both issues and pull requests
+	if !opts.IncludePullRequests {
+		search = append([]string{"is:issue"}, search...)
+	}
+
+	search = append(search, labelQualifiers(opts.Labels, opts.LabelsMatch)...)
+
+	if q := viewerQualifier(opts.Viewer); q != "" {
+		search = append(search, q)
+	}
+
 	if opts.Query != nil {
 		search = append(search, *opts.Query)
 	}
 
 	var (
 		variables = map[string]interface{}{
-			"cursor": (*githubv4.String)(nil),
-			"query":  githubv4.String(strings.Join(search, " ")),
+			"cursor":               (*githubv4.String)(nil),
+			"query":                githubv4.String(strings.Join(search, " ")),
+			"includeBody":          githubv4.Boolean(opts.IncludeBody),
+			"includeReopenedCount": githubv4.Boolean(opts.IncludeReopenedCount),
 		}
 
 		issues = []Issue{}
+		count  int64
 	)
 
 	for {
 		q := &QuerySearchIssues{}
 		if err := client.Query(ctx, q, variables); err != nil {
-			return nil, errors.WithStack(err)
+			return nil, 0, errors.WithStack(err)
 		}
+		count = q.Search.IssueCount
 		is := make([]Issue, len(q.Search.Nodes))
 
 		for i, v := range q.Search.Nodes {
 			is[i] = v.Issue
+			if v.PullRequest.IsPullRequest() {
+				is[i] = v.PullRequest
+			}
+		}
+
+		if opts.ExcludeBots {
+			is = filterBotIssues(is)
 		}
 
 		issues = append(issues, is...)
 
+		if stopAboveLimit && count > SearchResultLimit {
+			break
+		}
+
 		if !q.Search.PageInfo.HasNextPage {
 			break
 		}
 		variables["cursor"] = q.Search.PageInfo.EndCursor
 	}
 
-	return issues, nil
+	return issues, count, nil
+}
+
+// issueRangeSplitter recursively splits a time range in half until the issue search of every part matches less than SearchResultLimit issues.
+// It satisfies the Client interface to count the number of requests, so that the splitting can stop at AutoSplitMaxRequests
+type issueRangeSplitter struct {
+	client   Client
+	opts     models.ListIssuesOptions
+	requests int
+}
+
+// Query sends the query with the wrapped client and counts the request
+func (s *issueRangeSplitter) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	s.requests++
+	return s.client.Query(ctx, q, variables)
+}
+
+func (s *issueRangeSplitter) unwrap() Client {
+	return s.client
+}
+
+// search lists the issues in the time range, splitting it if the search matches too many issues.
+// A time range is not split further once it reaches AutoSplitMaxDepth, becomes shorter than two seconds, or once AutoSplitMaxRequests requests were sent
+func (s *issueRangeSplitter) search(ctx context.Context, from time.Time, to time.Time, depth int) (Issues, int64, error) {
+	split := depth < AutoSplitMaxDepth && s.requests < AutoSplitMaxRequests && to.Sub(from) >= 2*time.Second
+
+	issues, count, err := searchIssuesInRange(ctx, s, s.opts, from, to, split)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !split || count <= SearchResultLimit {
+		return issues, count, nil
+	}
+
+	// The time range qualifier includes both ends, so the second half starts a second after the first half ends
+	mid := from.Add(to.Sub(from) / 2).Truncate(time.Second)
+
+	first, firstCount, err := s.search(ctx, from, mid, depth+1)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	second, secondCount, err := s.search(ctx, mid.Add(time.Second), to, depth+1)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if secondCount > firstCount {
+		firstCount = secondCount
+	}
+
+	return mergeIssues(first, second), firstCount, nil
+}
+
+// mergeIssues appends the second list of issues to the first, without the issues that are already in the first list
+func mergeIssues(first Issues, second Issues) Issues {
+	seen := make(map[string]bool, len(first))
+	for _, v := range first {
+		seen[fmt.Sprintf("%s#%d", v.Repository.NameWithOwner, v.Number)] = true
+	}
+
+	for _, v := range second {
+		if !seen[fmt.Sprintf("%s#%d", v.Repository.NameWithOwner, v.Number)] {
+			first = append(first, v)
+		}
+	}
+
+	return first
+}
+
+// issueSearchScope returns the search qualifier that limits the issues to either a single repository or every repository in an organization.
+// Like the pull requests search, the Owner is used as the organization if the Org option is not set.
+func issueSearchScope(opts models.ListIssuesOptions) string {
+	if opts.Repository == "" {
+		org := opts.Org
+		if org == "" {
+			org = opts.Owner
+		}
+		return fmt.Sprintf("org:%s", org)
+	}
+
+	return fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository)
+}
+
+// filterBotIssues removes the issues that were opened by a bot account
+func filterBotIssues(issues []Issue) []Issue {
+	filtered := []Issue{}
+	for _, v := range issues {
+		if !v.Author.IsBot() {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
 }
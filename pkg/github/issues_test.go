@@ -2,11 +2,13 @@ package github
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/grafana/github-datasource/pkg/models"
 	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -33,6 +35,22 @@ func TestSearchIssues(t *testing.T) {
 	}
 }
 
+func TestIssueSearchScope(t *testing.T) {
+	for _, tc := range []struct {
+		opts     models.ListIssuesOptions
+		expected string
+	}{
+		{opts: models.ListIssuesOptions{Owner: "grafana", Repository: "grafana"}, expected: "repo:grafana/grafana"},
+		{opts: models.ListIssuesOptions{Owner: "grafana", Repository: "grafana", Org: "prometheus"}, expected: "repo:grafana/grafana"},
+		{opts: models.ListIssuesOptions{Owner: "grafana", Org: "prometheus"}, expected: "org:prometheus"},
+		{opts: models.ListIssuesOptions{Owner: "grafana"}, expected: "org:grafana"},
+	} {
+		if scope := issueSearchScope(tc.opts); scope != tc.expected {
+			t.Errorf("unexpected search scope. Expected '%s', received '%s'", tc.expected, scope)
+		}
+	}
+}
+
 func TestIssuesDataframe(t *testing.T) {
 	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
 	if err != nil {
@@ -50,9 +68,9 @@ func TestIssuesDataframe(t *testing.T) {
 				Time: createdAt,
 			},
 			Closed: false,
-			Author: struct {
-				User "graphql:\"... on User\""
-			}{
+			Author: Author{
+				Typename: "User",
+				Login:    "firstUser",
 				User: User{
 					ID:      "1",
 					Login:   "firstUser",
@@ -88,9 +106,9 @@ func TestIssuesDataframe(t *testing.T) {
 				Time: createdAt,
 			},
 			Closed: true,
-			Author: struct {
-				User "graphql:\"... on User\""
-			}{
+			Author: Author{
+				Typename: "User",
+				Login:    "secondUser",
 				User: User{
 					ID:      "2",
 					Login:   "secondUser",
@@ -126,9 +144,9 @@ func TestIssuesDataframe(t *testing.T) {
 				Time: createdAt,
 			},
 			Closed: false,
-			Author: struct {
-				User "graphql:\"... on User\""
-			}{
+			Author: Author{
+				Typename: "User",
+				Login:    "firstUser",
 				User: User{
 					ID:      "3",
 					Login:   "firstUser",
@@ -154,9 +172,527 @@ func TestIssuesDataframe(t *testing.T) {
 				},
 			},
 		},
+		Issue{
+			Number: 4,
+			Title:  "Issue #4",
+			ClosedAt: githubv4.DateTime{
+				Time: time.Time{},
+			},
+			CreatedAt: githubv4.DateTime{
+				Time: createdAt,
+			},
+			Closed: false,
+			Author: Author{
+				Typename: AuthorTypeBot,
+				Login:    "dependabot",
+			},
+			Repository: Repository{
+				Name: "grafana",
+				Owner: struct{ Login string }{
+					Login: "grafana",
+				},
+				NameWithOwner: "grafana/grafana",
+				URL:           "github.com/grafana/grafana",
+				ForkCount:     10,
+				IsFork:        true,
+				IsMirror:      true,
+				IsPrivate:     false,
+				CreatedAt: githubv4.DateTime{
+					Time: createdAt,
+				},
+			},
+		},
 	}
 
 	if err := testutil.CheckGoldenFramer("issues", issues); err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestFilterBotIssues(t *testing.T) {
+	issues := []Issue{
+		{
+			Number: 1,
+			Author: Author{Typename: "User", Login: "firstUser"},
+		},
+		{
+			Number: 2,
+			Author: Author{Typename: AuthorTypeBot, Login: "dependabot"},
+		},
+		{
+			Number: 3,
+			Author: Author{Typename: "Organization", Login: "grafana"},
+		},
+	}
+
+	filtered := filterBotIssues(issues)
+	if len(filtered) != 2 {
+		t.Fatalf("Unexpected number of issues. Expected 2, received %d", len(filtered))
+	}
+
+	for _, v := range filtered {
+		if v.Author.IsBot() {
+			t.Fatalf("Issue #%d was opened by a bot and should have been filtered out", v.Number)
+		}
+	}
+}
+
+func TestIssuesNormalizedCompanyDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := IssuesWrapper{
+		Issues: Issues{
+			{
+				Number:    1,
+				Title:     "Issue #1",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+				Author: Author{
+					Typename: "User",
+					Login:    "firstUser",
+					User:     User{Login: "firstUser", Company: "@grafana "},
+				},
+			},
+			{
+				Number:    2,
+				Title:     "Issue #2",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+				Author: Author{
+					Typename: "User",
+					Login:    "secondUser",
+					User:     User{Login: "secondUser", Company: "grafana"},
+				},
+			},
+		},
+		Options: models.ListIssuesOptions{
+			NormalizeCompany: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("issues_normalized_company", issues); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIssuesBucketedDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := Issues{}
+	for i, offset := range []int{0, 1, 2, 16, 17, 30} {
+		issue := Issue{
+			Number: int64(i + 1),
+			Title:  "Issue",
+			CreatedAt: githubv4.DateTime{
+				Time: createdAt.AddDate(0, 0, offset),
+			},
+		}
+		if offset%2 == 0 {
+			issue.Closed = true
+			issue.ClosedAt = githubv4.DateTime{
+				Time: createdAt.AddDate(0, 0, offset+3),
+			}
+		}
+		issues = append(issues, issue)
+	}
+
+	created := IssuesWrapper{
+		Issues: issues,
+		Options: models.ListIssuesOptions{
+			Bucket:      models.BucketWeek,
+			BucketField: models.IssueCreatedAt,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("issues_bucketed_created", created); err != nil {
+		t.Fatal(err)
+	}
+
+	closed := IssuesWrapper{
+		Issues: issues,
+		Options: models.ListIssuesOptions{
+			Bucket:      models.BucketWeek,
+			BucketField: models.IssuetClosedAt,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("issues_bucketed_closed", closed); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIssuesSearchLimitNotice(t *testing.T) {
+	t.Run("a notice is added if the search matched more issues than GitHub returns", func(t *testing.T) {
+		frames := IssuesWrapper{Issues: Issues{}, IssueCount: 2500}.Frames()
+		if frames[0].Meta == nil || len(frames[0].Meta.Notices) != 1 {
+			t.Fatalf("Expected a notice in the frame meta data")
+		}
+
+		if !strings.Contains(frames[0].Meta.Notices[0].Text, "2500") {
+			t.Errorf("Expected the notice to contain the number of matching issues, received '%s'", frames[0].Meta.Notices[0].Text)
+		}
+	})
+
+	t.Run("no notice is added if every issue was returned", func(t *testing.T) {
+		frames := IssuesWrapper{Issues: Issues{}, IssueCount: SearchResultLimit}.Frames()
+		if frames[0].Meta != nil {
+			t.Fatalf("Expected no frame meta data, received %v", frames[0].Meta)
+		}
+	})
+}
+
+// splitSearchClient responds to issue searches with the number of matches in `counts`, one per request
+type splitSearchClient struct {
+	counts  []int64
+	queries []string
+}
+
+func (c *splitSearchClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	n := len(c.queries)
+	c.queries = append(c.queries, string(variables["query"].(githubv4.String)))
+
+	search := &q.(*QuerySearchIssues).Search
+	search.IssueCount = c.counts[n]
+	// Every search returns the same issue, which has to be deduplicated, and an issue that is unique to the search
+	search.Nodes = make([]struct {
+		Issue       Issue `graphql:"... on Issue"`
+		PullRequest Issue `graphql:"... on PullRequest"`
+	}, 2)
+	search.Nodes[0].Issue.Number = 1
+	search.Nodes[1].Issue.Number = int64(n + 1)
+
+	return nil
+}
+
+func TestSearchIssuesAutoSplitRange(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		from = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+		opts = models.ListIssuesOptions{
+			Repository:     "grafana",
+			Owner:          "grafana",
+			TimeField:      models.IssueCreatedAt,
+			AutoSplitRange: true,
+		}
+	)
+
+	client := &splitSearchClient{counts: []int64{1500, 700, 800}}
+
+	issues, count, err := searchIssues(ctx, client, opts, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.queries) != 3 {
+		t.Fatalf("Expected the time range to be split in two searches, received %d searches", len(client.queries))
+	}
+
+	if !strings.Contains(client.queries[1], "created:2020-01-01T00:00:00Z..2020-01-02T00:00:00Z") {
+		t.Errorf("Unexpected query for the first half of the time range: '%s'", client.queries[1])
+	}
+
+	if !strings.Contains(client.queries[2], "created:2020-01-02T00:00:01Z..2020-01-03T00:00:00Z") {
+		t.Errorf("Unexpected query for the second half of the time range: '%s'", client.queries[2])
+	}
+
+	if count != 800 {
+		t.Errorf("Expected the largest number of matches of a single search, received %d", count)
+	}
+
+	numbers := []int64{}
+	for _, v := range issues {
+		numbers = append(numbers, v.Number)
+	}
+
+	if len(numbers) != 3 || numbers[0] != 1 || numbers[1] != 2 || numbers[2] != 3 {
+		t.Errorf("Expected the issues of both searches without duplicates, received %v", numbers)
+	}
+}
+
+func TestSearchIssuesIncludePullRequests(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		from = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+		opts = models.ListIssuesOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+		}
+	)
+
+	client := &splitSearchClient{counts: []int64{10, 10}}
+
+	if _, _, err := searchIssues(ctx, client, opts, from, to); err != nil {
+		t.Fatal(err)
+	}
+
+	opts.IncludePullRequests = true
+	if _, _, err := searchIssues(ctx, client, opts, from, to); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(client.queries[0], "is:issue ") {
+		t.Errorf("Expected the search to be limited to issues, received '%s'", client.queries[0])
+	}
+
+	if strings.Contains(client.queries[1], "is:issue") {
+		t.Errorf("Expected the search to include pull requests, received '%s'", client.queries[1])
+	}
+}
+
+func TestIssuesWithPullRequestsDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := IssuesWrapper{
+		Issues: Issues{
+			{
+				Number:    1,
+				Title:     "Issue #1",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+				Typename:  "Issue",
+			},
+			{
+				Number:    2,
+				Title:     "Pull Request #2",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+				Typename:  "PullRequest",
+			},
+		},
+		Options: models.ListIssuesOptions{
+			IncludePullRequests: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("issues_with_pull_requests", issues); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIssuesWithBodyDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := IssuesWrapper{
+		Issues: Issues{
+			{
+				Number:    1,
+				Title:     "Issue #1",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+				Body:      "Short description",
+			},
+			{
+				Number:    2,
+				Title:     "Issue #2",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+				Body:      "A description that is longer than the maximum length",
+			},
+		},
+		Options: models.ListIssuesOptions{
+			IncludeBody:   true,
+			BodyMaxLength: 20,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("issues_with_body", issues); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIssuesWithReopenedCountDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := IssuesWrapper{
+		Issues: Issues{
+			{
+				Number:    1,
+				Title:     "Issue #1",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+			},
+			{
+				Number:    2,
+				Title:     "Issue #2",
+				CreatedAt: githubv4.DateTime{Time: createdAt},
+			},
+		},
+		Options: models.ListIssuesOptions{
+			IncludeReopenedCount: true,
+		},
+	}
+	issues.Issues[1].ReopenedEvents.TotalCount = 3
+
+	if err := testutil.CheckGoldenFramer("issues_reopened_count", issues); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTruncateBody(t *testing.T) {
+	t.Run("short descriptions should not be changed", func(t *testing.T) {
+		if body := truncateBody("Fixes a bug", 20); body != "Fixes a bug" {
+			t.Fatalf("Unexpected body. Expected 'Fixes a bug', received '%s'", body)
+		}
+	})
+
+	t.Run("long descriptions should be cut off by character, not byte", func(t *testing.T) {
+		if body := truncateBody("Grüße aus Köln", 5); body != "Grüße…" {
+			t.Fatalf("Unexpected body. Expected 'Grüße…', received '%s'", body)
+		}
+	})
+
+	t.Run("the default maximum length should be used if it is not set", func(t *testing.T) {
+		body := truncateBody(strings.Repeat("a", models.DefaultBodyMaxLength+1), 0)
+		if len([]rune(body)) != models.DefaultBodyMaxLength+1 || !strings.HasSuffix(body, "…") {
+			t.Fatalf("Expected the body to be cut off after %d characters, received %d characters", models.DefaultBodyMaxLength, len([]rune(body)))
+		}
+	})
+}
+
+func TestIssuesSplitByStateDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := Issues{
+		{
+			Number:     1,
+			Title:      "Issue #1",
+			Closed:     true,
+			CreatedAt:  githubv4.DateTime{Time: createdAt},
+			ClosedAt:   githubv4.DateTime{Time: createdAt.Add(26 * time.Hour)},
+			Repository: Repository{NameWithOwner: "grafana/grafana"},
+		},
+		{
+			Number:     2,
+			Title:      "Issue #2",
+			CreatedAt:  githubv4.DateTime{Time: createdAt.Add(2 * time.Hour)},
+			Repository: Repository{NameWithOwner: "grafana/grafana"},
+		},
+	}
+
+	t.Run("every issue should be in the opened frame, and the closed issues in the closed frame", func(t *testing.T) {
+		split := IssuesWrapper{
+			Issues:  issues,
+			Closed:  issues[:1],
+			Options: models.ListIssuesOptions{SplitByState: true},
+		}
+
+		if err := testutil.CheckGoldenFramer("issues_split_by_state", split); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("both frames should be bucketed if a bucket is set", func(t *testing.T) {
+		split := IssuesWrapper{
+			Issues:  issues,
+			Closed:  issues[:1],
+			Options: models.ListIssuesOptions{SplitByState: true, Bucket: models.BucketDay},
+		}
+
+		if err := testutil.CheckGoldenFramer("issues_split_by_state_bucketed", split); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// stateSearchClient responds to the search for the issues closed in the time range with the closed issues, and to every other search with the opened issues
+type stateSearchClient struct {
+	opened Issues
+	closed Issues
+}
+
+func (c *stateSearchClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	issues := c.opened
+	if strings.Contains(string(variables["query"].(githubv4.String)), "closed:") {
+		issues = c.closed
+	}
+
+	search := q.(*QuerySearchIssues)
+	for _, v := range issues {
+		search.Search.Nodes = append(search.Search.Nodes, struct {
+			Issue       Issue `graphql:"... on Issue"`
+			PullRequest Issue `graphql:"... on PullRequest"`
+		}{Issue: v})
+	}
+	search.Search.IssueCount = int64(len(issues))
+
+	return nil
+}
+
+func TestSearchOpenedAndClosedIssues(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC)
+
+		opened = Issue{Number: 1, Typename: "Issue", CreatedAt: githubv4.DateTime{Time: from.Add(24 * time.Hour)}}
+		// This issue was created before the time range but closed in it, so it is only found by the search for the closed issues
+		closed = Issue{
+			Number:    2,
+			Typename:  "Issue",
+			Closed:    true,
+			CreatedAt: githubv4.DateTime{Time: from.Add(-30 * 24 * time.Hour)},
+			ClosedAt:  githubv4.DateTime{Time: from.Add(48 * time.Hour)},
+		}
+	)
+
+	client := &stateSearchClient{opened: Issues{opened}, closed: Issues{closed}}
+	opts := models.ListIssuesOptions{Owner: "grafana", Repository: "grafana", SplitByState: true}
+
+	issues, closedIssues, count, err := searchOpenedAndClosedIssues(context.Background(), client, opts, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frames := IssuesWrapper{Issues: issues, Closed: closedIssues, Options: opts, IssueCount: count}.Frames()
+	if len(frames) != 2 || frames[0].Name != "opened" || frames[1].Name != "closed" {
+		t.Fatalf("Expected an opened and a closed frame, received %d frames", len(frames))
+	}
+
+	if rows, _ := frames[0].RowLen(); rows != 1 {
+		t.Fatalf("Expected 1 opened issue, received %d", rows)
+	}
+
+	closedRows, _ := frames[1].RowLen()
+	if closedRows != 1 || frames[1].Fields[4].At(0).(int64) != 2 {
+		t.Fatalf("Expected the issue created before the time range in the closed frame, received %d rows", closedRows)
+	}
+}
+
+func TestHandleIssuesQueryBucketField(t *testing.T) {
+	var (
+		client = &searchQueryClient{}
+		d      = &Datasource{client: client}
+		from   = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to     = time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC)
+		query  = &models.IssuesQuery{
+			Options: models.ListIssuesOptions{
+				TimeField:   models.IssueCreatedAt,
+				Bucket:      models.BucketWeek,
+				BucketField: models.IssuetClosedAt,
+			},
+		}
+	)
+	query.Owner = "grafana"
+	query.Repository = "grafana"
+
+	if _, err := d.HandleIssuesQuery(context.Background(), query, backend.DataQuery{TimeRange: backend.TimeRange{From: from, To: to}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The issues that are bucketed by the time they were closed have to be searched by it, so that the issues created before the time range are included
+	if !strings.Contains(client.query, "closed:2020-08-01T00:00:00Z..2020-08-31T00:00:00Z") || strings.Contains(client.query, "created:") {
+		t.Fatalf("Expected the issues closed in the time range to be searched, received '%s'", client.query)
+	}
+}
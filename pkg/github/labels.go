@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/grafana/github-datasource/pkg/models"
 	"github.com/grafana/grafana-plugin-sdk-go/data"
@@ -35,6 +37,20 @@ type Label struct {
 	Color       string `json:"color"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+
+	// Issues is only requested when the IssueCounts option is set
+	Issues struct {
+		TotalCount int64
+	} `json:"-" graphql:"issues(states: OPEN) @include(if: $includeIssueCounts)"`
+}
+
+// HexColor returns the color of the label as a hex color code (ex: #d73a4a). GitHub returns the color without the leading '#'
+func (l Label) HexColor() string {
+	if l.Color == "" || strings.HasPrefix(l.Color, "#") {
+		return l.Color
+	}
+
+	return fmt.Sprintf("#%s", l.Color)
 }
 
 // Labels is a list of GitHub labels
@@ -42,24 +58,60 @@ type Labels []Label
 
 // Frames converts the list of labels to a Grafana DataFrame
 func (a Labels) Frames() data.Frames {
+	return LabelsWrapper{Labels: a}.Frames()
+}
+
+// LabelsWrapper is a list of GitHub labels along with the query options that change how they are converted to a data frame
+type LabelsWrapper struct {
+	Labels  Labels
+	Options models.ListLabelsOptions
+}
+
+// Frames converts the list of labels to a Grafana DataFrame.
+// If the IssueCounts option is set, the frame has a single row with the number of open issues per label instead
+func (w LabelsWrapper) Frames() data.Frames {
+	if w.Options.IssueCounts {
+		return w.issueCountFrames()
+	}
+
 	frame := data.NewFrame(
 		"labels",
 		data.NewField("color", nil, []string{}),
 		data.NewField("name", nil, []string{}),
 		data.NewField("description", nil, []string{}),
+		data.NewField("hex_color", nil, []string{}),
 	)
 
-	for _, v := range a {
+	for _, v := range w.Labels {
 		frame.AppendRow(
 			v.Color,
 			v.Name,
 			v.Description,
+			v.HexColor(),
 		)
 	}
 
 	return data.Frames{frame}
 }
 
+// issueCountFrames creates a frame with one column per label that has the number of open issues with that label.
+// The color of the label is set in the field config, so that panels use the same colors as GitHub
+func (w LabelsWrapper) issueCountFrames() data.Frames {
+	fields := make([]*data.Field, len(w.Labels))
+	for i, v := range w.Labels {
+		field := data.NewField(v.Name, nil, []int64{v.Issues.TotalCount})
+		field.Config = &data.FieldConfig{
+			Color: map[string]interface{}{
+				"mode":       "fixed",
+				"fixedColor": v.HexColor(),
+			},
+		}
+		fields[i] = field
+	}
+
+	return data.Frames{data.NewFrame("label_issue_counts", fields...)}
+}
+
 // GetAllLabels gets all labels from a GitHub repository
 func GetAllLabels(ctx context.Context, client Client, opts models.ListLabelsOptions) (Labels, error) {
 	var (
@@ -68,6 +120,8 @@ func GetAllLabels(ctx context.Context, client Client, opts models.ListLabelsOpti
 			"query":  githubv4.String(opts.Query),
 			"owner":  githubv4.String(opts.Owner),
 			"name":   githubv4.String(opts.Repository),
+
+			"includeIssueCounts": githubv4.Boolean(opts.IssueCounts),
 		}
 
 		labels = Labels{}
@@ -89,3 +143,29 @@ func GetAllLabels(ctx context.Context, client Client, opts models.ListLabelsOpti
 
 	return labels, nil
 }
+
+// labelQualifiers returns the search qualifiers that filter issues or pull requests by labels.
+// GitHub combines multiple `label:` qualifiers with AND, and the comma separated values of a single qualifier with OR
+func labelQualifiers(labels []string, match models.LabelsMatch) []string {
+	quoted := []string{}
+	for _, v := range labels {
+		if v = strings.TrimSpace(v); v != "" {
+			quoted = append(quoted, fmt.Sprintf(`"%s"`, v))
+		}
+	}
+
+	if len(quoted) == 0 {
+		return nil
+	}
+
+	if match == models.LabelsMatchAny {
+		return []string{"label:" + strings.Join(quoted, ",")}
+	}
+
+	qualifiers := make([]string, len(quoted))
+	for i, v := range quoted {
+		qualifiers[i] = "label:" + v
+	}
+
+	return qualifiers
+}
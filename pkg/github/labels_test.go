@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/grafana/github-datasource/pkg/models"
@@ -30,3 +31,72 @@ func TestListLabels(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func testLabels() Labels {
+	labels := Labels{
+		{
+			Color:       "d73a4a",
+			Name:        "type/bug",
+			Description: "Something isn't working",
+		},
+		{
+			Color:       "a2eeef",
+			Name:        "type/feature-request",
+			Description: "New feature or request",
+		},
+	}
+
+	labels[0].Issues.TotalCount = 42
+	labels[1].Issues.TotalCount = 7
+
+	return labels
+}
+
+func TestLabelsDataframe(t *testing.T) {
+	if err := testutil.CheckGoldenFramer("labels", testLabels()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLabelIssueCountsDataframe(t *testing.T) {
+	labels := LabelsWrapper{
+		Labels: testLabels(),
+		Options: models.ListLabelsOptions{
+			IssueCounts: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("label_issue_counts", labels); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLabelQualifiers(t *testing.T) {
+	labels := []string{"type/bug", " regression ", ""}
+
+	t.Run("Every label should be a separate qualifier when matching all labels", func(t *testing.T) {
+		var (
+			result = strings.Join(labelQualifiers(labels, models.LabelsMatchAll), " ")
+			expect = `label:"type/bug" label:"regression"`
+		)
+		if result != expect {
+			t.Fatalf("Unexpected label qualifiers. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("The labels should be combined in one qualifier when matching any label", func(t *testing.T) {
+		var (
+			result = strings.Join(labelQualifiers(labels, models.LabelsMatchAny), " ")
+			expect = `label:"type/bug","regression"`
+		)
+		if result != expect {
+			t.Fatalf("Unexpected label qualifiers. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("No qualifiers should be returned without labels", func(t *testing.T) {
+		if q := labelQualifiers(nil, models.LabelsMatchAny); len(q) != 0 {
+			t.Fatalf("Expected no label qualifiers, received %v", q)
+		}
+	})
+}
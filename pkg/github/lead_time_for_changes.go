@@ -0,0 +1,57 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// LeadTimeForChanges is the number of pull requests that were merged in a time range and the mean number of seconds from opening to merging them, and optionally the same in every time bucket of the range
+type LeadTimeForChanges struct {
+	DurationMeans
+}
+
+// Frames converts the lead time for changes to a Grafana DataFrame with a single row, or with one row per bucket if the pull requests are grouped into time buckets.
+// The mean is null if no pull request was merged
+func (l LeadTimeForChanges) Frames() data.Frames {
+	return data.Frames{l.frame("lead_time_for_changes", "pull_requests", "lead_time_for_changes")}
+}
+
+// leadTimeForChanges computes the mean time to merge the pull requests. If the bucket interval is set, the pull requests are also grouped by the bucket they were merged in
+func leadTimeForChanges(pullRequests PullRequests, bucket models.BucketInterval, loc *time.Location, from time.Time, to time.Time) LeadTimeForChanges {
+	durations := []timedDuration{}
+	for _, v := range pullRequests {
+		if seconds := v.TimeToMerge(); seconds != nil {
+			durations = append(durations, timedDuration{Time: v.MergedAt.Time, Seconds: *seconds})
+		}
+	}
+
+	return LeadTimeForChanges{meanDurations(durations, bucket, loc, from, to)}
+}
+
+// GetLeadTimeForChanges computes the mean time from opening to merging the pull requests that were merged in the time range, which approximates the lead time for changes of the DORA metrics.
+// The DORA metric is measured from the commit to the deployment, but the deployments of a repository are not always tracked in GitHub, so the time a pull request was open is used instead
+func GetLeadTimeForChanges(ctx context.Context, client Client, opts models.ListLeadTimeForChangesOptions, from time.Time, to time.Time) (LeadTimeForChanges, error) {
+	loc, err := bucketLocation(opts.Timezone)
+	if err != nil {
+		return LeadTimeForChanges{}, err
+	}
+
+	pullRequests, err := GetPullRequestsInRange(ctx, client, models.ListPullRequestsOptions{
+		Owner:       opts.Owner,
+		Repository:  opts.Repository,
+		TimeField:   models.PullRequestMergedAt,
+		State:       models.PullRequestStateMerged,
+		BaseBranch:  opts.BaseBranch,
+		ExcludeBots: opts.ExcludeBots,
+		// Only the creation and merge times are needed, so the optional parts of the query are skipped
+		Fields: []string{"created_at", "merged_at"},
+	}, from, to)
+	if err != nil {
+		return LeadTimeForChanges{}, err
+	}
+
+	return leadTimeForChanges(pullRequests, opts.Bucket, loc, from, to), nil
+}
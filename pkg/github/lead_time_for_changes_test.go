@@ -0,0 +1,75 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetLeadTimeForChanges(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	client := &searchQueryClient{}
+	opts := models.ListLeadTimeForChangesOptions{Owner: "grafana", Repository: "grafana", BaseBranch: "main"}
+
+	if _, err := GetLeadTimeForChanges(context.Background(), client, opts, from, to); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{"merged:2020-08-01T00:00:00Z..2020-08-31T00:00:00Z", "is:merged", `base:"main"`} {
+		if !strings.Contains(client.query, expected) {
+			t.Fatalf("Expected '%s' in the search query, received '%s'", expected, client.query)
+		}
+	}
+}
+
+func mergedPullRequests() PullRequests {
+	merged := func(created time.Time, hours time.Duration) PullRequest {
+		return PullRequest{
+			CreatedAt: githubv4.DateTime{Time: created},
+			MergedAt:  githubv4.DateTime{Time: created.Add(hours * time.Hour)},
+			Merged:    true,
+		}
+	}
+
+	return PullRequests{
+		merged(time.Date(2020, time.August, 3, 12, 0, 0, 0, time.UTC), 6),
+		merged(time.Date(2020, time.August, 4, 12, 0, 0, 0, time.UTC), 30),
+		merged(time.Date(2020, time.August, 10, 12, 0, 0, 0, time.UTC), 48),
+		// A pull request that was closed without being merged has no lead time
+		{
+			CreatedAt: githubv4.DateTime{Time: time.Date(2020, time.August, 11, 12, 0, 0, 0, time.UTC)},
+			ClosedAt:  githubv4.DateTime{Time: time.Date(2020, time.August, 12, 12, 0, 0, 0, time.UTC)},
+			Closed:    true,
+		},
+	}
+}
+
+func TestLeadTimeForChangesDataframe(t *testing.T) {
+	leadTime := leadTimeForChanges(mergedPullRequests(), models.BucketNone, time.UTC, time.Time{}, time.Time{})
+
+	if err := testutil.CheckGoldenFramer("lead_time_for_changes", leadTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLeadTimeForChangesBucketedDataframe(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 16, 0, 0, 0, 0, time.UTC)
+	)
+
+	leadTime := leadTimeForChanges(mergedPullRequests(), models.BucketWeek, time.UTC, from, to)
+
+	if err := testutil.CheckGoldenFramer("lead_time_for_changes_buckets", leadTime); err != nil {
+		t.Fatal(err)
+	}
+}
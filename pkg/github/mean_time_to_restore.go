@@ -0,0 +1,68 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// MeanTimeToRestore is the number of incidents that were closed in a time range and the mean number of seconds it took to close them, and optionally the same in every time bucket of the range
+type MeanTimeToRestore struct {
+	DurationMeans
+
+	// IncidentCount is the number of incidents that matched the largest search. If it is larger than SearchResultLimit, a notice is added to the frame because some incidents are missing
+	IncidentCount int64
+}
+
+// Frames converts the mean time to restore to a Grafana DataFrame with a single row, or with one row per bucket if the incidents are grouped into time buckets.
+// The mean is null if no incident was closed
+func (m MeanTimeToRestore) Frames() data.Frames {
+	frame := m.frame("mean_time_to_restore", "incidents", "mean_time_to_restore")
+	frame.Meta = noticesMeta(searchLimitNotice(m.IncidentCount, "issues", "Narrow the time range to include every incident in the mean time to restore"))
+
+	return data.Frames{frame}
+}
+
+// meanTimeToRestore computes the mean time to close the incidents. If the bucket interval is set, the incidents are also grouped by the bucket they were closed in
+func meanTimeToRestore(incidents Issues, bucket models.BucketInterval, loc *time.Location, from time.Time, to time.Time) MeanTimeToRestore {
+	durations := []timedDuration{}
+	for _, v := range incidents {
+		if seconds := v.TimeToClose(); seconds != nil {
+			durations = append(durations, timedDuration{Time: v.ClosedAt.Time, Seconds: *seconds})
+		}
+	}
+
+	return MeanTimeToRestore{DurationMeans: meanDurations(durations, bucket, loc, from, to)}
+}
+
+// GetMeanTimeToRestore computes the mean time to close the issues with the incident label that were closed in the time range, which is the mean time to restore of the DORA metrics.
+// The time range is split like with the AutoSplitRange option of the issues query, so that incidents are not missing because of the limit of GitHub's search API
+func GetMeanTimeToRestore(ctx context.Context, client Client, opts models.ListMeanTimeToRestoreOptions, from time.Time, to time.Time) (MeanTimeToRestore, error) {
+	loc, err := bucketLocation(opts.Timezone)
+	if err != nil {
+		return MeanTimeToRestore{}, err
+	}
+
+	label := opts.Label
+	if label == "" {
+		label = models.DefaultIncidentLabel
+	}
+
+	incidents, count, err := searchIssues(ctx, client, models.ListIssuesOptions{
+		Owner:          opts.Owner,
+		Repository:     opts.Repository,
+		TimeField:      models.IssuetClosedAt,
+		Labels:         []string{label},
+		AutoSplitRange: true,
+	}, from, to)
+	if err != nil {
+		return MeanTimeToRestore{}, err
+	}
+
+	mttr := meanTimeToRestore(incidents, opts.Bucket, loc, from, to)
+	mttr.IncidentCount = count
+
+	return mttr, nil
+}
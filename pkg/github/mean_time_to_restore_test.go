@@ -0,0 +1,126 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+// searchQueryClient records the search query of the issue search
+type searchQueryClient struct {
+	query string
+}
+
+func (c *searchQueryClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	c.query = string(variables["query"].(githubv4.String))
+	return nil
+}
+
+func TestGetMeanTimeToRestore(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	for label, expected := range map[string]string{
+		"":       `label:"incident"`,
+		"outage": `label:"outage"`,
+		"sev 1":  `label:"sev 1"`,
+	} {
+		client := &searchQueryClient{}
+		opts := models.ListMeanTimeToRestoreOptions{Owner: "grafana", Repository: "grafana", Label: label}
+
+		if _, err := GetMeanTimeToRestore(context.Background(), client, opts, from, to); err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(client.query, "closed:2020-08-01T00:00:00Z..2020-08-31T00:00:00Z") || !strings.Contains(client.query, expected) {
+			t.Fatalf("Expected the incidents closed in the time range with the label '%s', received '%s'", expected, client.query)
+		}
+	}
+}
+
+func TestGetMeanTimeToRestoreSearchLimit(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		from = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+		opts = models.ListMeanTimeToRestoreOptions{Owner: "grafana", Repository: "grafana"}
+	)
+
+	t.Run("the time range should be split if the search matches too many incidents", func(t *testing.T) {
+		client := &splitSearchClient{counts: []int64{1500, 700, 800}}
+
+		mttr, err := GetMeanTimeToRestore(ctx, client, opts, from, to)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(client.queries) != 3 {
+			t.Fatalf("Expected the time range to be split in two searches, received %d searches", len(client.queries))
+		}
+
+		if meta := mttr.Frames()[0].Meta; meta != nil {
+			t.Fatalf("Expected no frame meta data, received %v", meta)
+		}
+	})
+
+	t.Run("a notice should be added if a search still matches too many incidents", func(t *testing.T) {
+		client := &splitSearchClient{counts: []int64{2500}}
+
+		// A time range that is shorter than two seconds is not split
+		mttr, err := GetMeanTimeToRestore(ctx, client, opts, from, from.Add(time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		meta := mttr.Frames()[0].Meta
+		if meta == nil || len(meta.Notices) != 1 || !strings.Contains(meta.Notices[0].Text, "2500") {
+			t.Fatalf("Expected a notice about the search limit, received %v", meta)
+		}
+	})
+}
+
+func incidents() Issues {
+	incident := func(created time.Time, hours time.Duration) Issue {
+		return Issue{
+			CreatedAt: githubv4.DateTime{Time: created},
+			ClosedAt:  githubv4.DateTime{Time: created.Add(hours * time.Hour)},
+			Closed:    true,
+		}
+	}
+
+	return Issues{
+		incident(time.Date(2020, time.August, 3, 12, 0, 0, 0, time.UTC), 2),
+		incident(time.Date(2020, time.August, 4, 12, 0, 0, 0, time.UTC), 4),
+		incident(time.Date(2020, time.August, 12, 12, 0, 0, 0, time.UTC), 12),
+		// An incident that was reopened is not closed anymore
+		{CreatedAt: githubv4.DateTime{Time: time.Date(2020, time.August, 13, 12, 0, 0, 0, time.UTC)}},
+	}
+}
+
+func TestMeanTimeToRestoreDataframe(t *testing.T) {
+	mttr := meanTimeToRestore(incidents(), models.BucketNone, time.UTC, time.Time{}, time.Time{})
+
+	if err := testutil.CheckGoldenFramer("mean_time_to_restore", mttr); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMeanTimeToRestoreBucketedDataframe(t *testing.T) {
+	var (
+		from = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2020, time.August, 16, 0, 0, 0, 0, time.UTC)
+	)
+
+	mttr := meanTimeToRestore(incidents(), models.BucketWeek, time.UTC, from, to)
+
+	if err := testutil.CheckGoldenFramer("mean_time_to_restore_buckets", mttr); err != nil {
+		t.Fatal(err)
+	}
+}
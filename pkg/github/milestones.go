@@ -49,6 +49,21 @@ type Milestones []Milestone
 
 // Frames converts the list of GitHub Milestones to a Grafana data frame
 func (m Milestones) Frames() data.Frames {
+	return MilestonesWrapper{Milestones: m}.Frames()
+}
+
+// MilestonesWrapper is a list of GitHub milestones along with the query options that change how they are converted to a data frame
+type MilestonesWrapper struct {
+	Milestones Milestones
+	Options    models.ListMilestonesOptions
+}
+
+// Frames converts the list of GitHub Milestones to a Grafana data frame. If the Annotations option is set, the frame can be used as an annotation source
+func (w MilestonesWrapper) Frames() data.Frames {
+	if w.Options.Annotations {
+		return w.annotationFrames()
+	}
+
 	frame := data.NewFrame(
 		"milestones",
 		data.NewField("title", nil, []string{}),
@@ -60,7 +75,7 @@ func (m Milestones) Frames() data.Frames {
 		data.NewField("due_at", nil, []*time.Time{}),
 	)
 
-	for _, v := range m {
+	for _, v := range w.Milestones {
 		var (
 			closedAt *time.Time
 			dueAt    *time.Time
@@ -89,6 +104,30 @@ func (m Milestones) Frames() data.Frames {
 	return data.Frames{frame}
 }
 
+// annotationFrames converts the milestones with a due date to a data frame with the `time` and `text` fields that Grafana uses for annotations
+func (w MilestonesWrapper) annotationFrames() data.Frames {
+	frame := data.NewFrame(
+		"milestones",
+		data.NewField("time", nil, []time.Time{}),
+		data.NewField("text", nil, []string{}),
+		data.NewField("state", nil, []string{}),
+	)
+
+	for _, v := range w.Milestones {
+		if v.DueOn.Time.IsZero() {
+			continue
+		}
+
+		frame.AppendRow(
+			v.DueOn.Time,
+			v.Title,
+			string(v.State),
+		)
+	}
+
+	return data.Frames{frame}
+}
+
 // GetAllMilestones lists milestones in a repository
 func GetAllMilestones(ctx context.Context, client Client, opts models.ListMilestonesOptions) (Milestones, error) {
 	var (
@@ -113,4 +113,24 @@ func TestMilestonesDataframe(t *testing.T) {
 	if err := testutil.CheckGoldenFramer("milestones", milestones); err != nil {
 		t.Fatal(err)
 	}
+
+	// Milestones without a due date are not annotations
+	milestones = append(milestones, Milestone{
+		CreatedAt: githubv4.DateTime{
+			Time: openedAt,
+		},
+		State: githubv4.MilestoneStateOpen,
+		Title: "backlog",
+	})
+
+	annotations := MilestonesWrapper{
+		Milestones: milestones,
+		Options: models.ListMilestonesOptions{
+			Annotations: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("milestone_annotations", annotations); err != nil {
+		t.Fatal(err)
+	}
 }
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// PinnedIssue is an issue that is pinned to the top of the issues of a repository
+type PinnedIssue struct {
+	Number int64
+	Title  string
+	URL    string
+	Closed bool
+}
+
+// PinnedIssues is a list of pinned issues, in the order they are pinned in
+type PinnedIssues []PinnedIssue
+
+// Frames converts the list of pinned issues to a Grafana DataFrame
+func (p PinnedIssues) Frames() data.Frames {
+	frame := data.NewFrame(
+		"pinned_issues",
+		data.NewField("position", nil, []int64{}),
+		data.NewField("number", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("closed", nil, []bool{}),
+	)
+
+	for i, v := range p {
+		frame.AppendRow(
+			int64(i+1),
+			v.Number,
+			v.Title,
+			v.URL,
+			v.Closed,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QueryListPinnedIssues lists the pinned issues of a repository
+// {
+//   repository(name: "grafana", owner: "grafana") {
+//     pinnedIssues(first: 100) {
+//       nodes {
+//         issue {
+//           number
+//           title
+//           url
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryListPinnedIssues struct {
+	Repository struct {
+		PinnedIssues struct {
+			Nodes []struct {
+				Issue PinnedIssue
+			}
+			PageInfo PageInfo
+		} `graphql:"pinnedIssues(first: 100, after: $cursor)"`
+	} `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// GetPinnedIssues lists the pinned issues of a repository in the order they are pinned in. GitHub allows at most 3 pinned issues per repository
+func GetPinnedIssues(ctx context.Context, client Client, opts models.ListPinnedIssuesOptions) (PinnedIssues, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"owner":  githubv4.String(opts.Owner),
+			"name":   githubv4.String(opts.Repository),
+		}
+
+		issues = PinnedIssues{}
+	)
+
+	for {
+		q := &QueryListPinnedIssues{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Repository.PinnedIssues.Nodes {
+			issues = append(issues, v.Issue)
+		}
+
+		if !q.Repository.PinnedIssues.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Repository.PinnedIssues.PageInfo.EndCursor
+	}
+
+	return issues, nil
+}
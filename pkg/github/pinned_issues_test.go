@@ -0,0 +1,41 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+func TestListPinnedIssues(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("name", "owner", "cursor"),
+		testutil.GetTestQueryFunction(&QueryListPinnedIssues{}),
+	)
+
+	_, err := GetPinnedIssues(context.Background(), client, models.ListPinnedIssuesOptions{Owner: "grafana", Repository: "grafana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPinnedIssuesDataframe(t *testing.T) {
+	issues := PinnedIssues{
+		{
+			Number: 12,
+			Title:  "Roadmap",
+			URL:    "https://github.com/grafana/github-datasource/issues/12",
+		},
+		{
+			Number: 3,
+			Title:  "Known issues with GitHub Enterprise",
+			URL:    "https://github.com/grafana/github-datasource/issues/3",
+			Closed: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("pinned_issues", issues); err != nil {
+		t.Fatal(err)
+	}
+}
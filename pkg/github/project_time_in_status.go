@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// ProjectItemStatus is an item in a GitHub Project (v2) along with its current status and the time that it has had that status since
+type ProjectItemStatus struct {
+	Item   ProjectItem
+	Status string
+	Since  time.Time
+}
+
+// ProjectItemStatuses is a list of project items and their current status, along with the time that the time in status is measured at
+type ProjectItemStatuses struct {
+	Items []ProjectItemStatus
+	Now   time.Time
+}
+
+// Frames converts the list of project item statuses to a Grafana DataFrame
+func (p ProjectItemStatuses) Frames() data.Frames {
+	frame := data.NewFrame(
+		"project_time_in_status",
+		data.NewField("id", nil, []string{}),
+		data.NewField("type", nil, []string{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("number", nil, []*int64{}),
+		data.NewField("repo", nil, []string{}),
+		data.NewField("status", nil, []string{}),
+		data.NewField("status_since", nil, []time.Time{}),
+		data.NewField("days_in_status", nil, []int64{}),
+	)
+
+	for _, v := range p.Items {
+		frame.AppendRow(
+			v.Item.ID,
+			v.Item.Type,
+			v.Item.Title(),
+			v.Item.Number(),
+			v.Item.Repository(),
+			v.Status,
+			v.Since,
+			daysBetween(v.Since, p.Now),
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// itemStatus returns the value of the status field of a project item and the last time that it was changed.
+// GitHub does not keep the history of a field value, so if the time the status was set is not known, the last time the item was updated is used instead.
+// Items without a status have an empty status
+func itemStatus(item ProjectItem, field string) ProjectItemStatus {
+	status := ProjectItemStatus{
+		Item:  item,
+		Since: item.UpdatedAt.Time,
+	}
+
+	value := item.FieldValue(field)
+	if value == nil || value.Typename != ProjectV2ItemFieldSingleSelectValue {
+		return status
+	}
+
+	status.Status = value.SingleSelect.Name
+	if !value.SingleSelect.UpdatedAt.IsZero() {
+		status.Since = value.SingleSelect.UpdatedAt.Time
+	}
+
+	return status
+}
+
+// GetProjectTimeInStatus lists the items in an organization's GitHub Project (v2) along with how long they have had their current status at `now`.
+// Archived items are skipped.
+func GetProjectTimeInStatus(ctx context.Context, client Client, opts models.ListProjectTimeInStatusOptions, now time.Time) (ProjectItemStatuses, error) {
+	items, err := GetAllProjectItems(ctx, client, models.ListProjectItemsOptions{
+		Owner:  opts.Owner,
+		Number: opts.Number,
+	})
+	if err != nil {
+		return ProjectItemStatuses{}, err
+	}
+
+	field := opts.StatusField
+	if field == "" {
+		field = models.DefaultProjectStatusField
+	}
+
+	statuses := ProjectItemStatuses{
+		Items: []ProjectItemStatus{},
+		Now:   now,
+	}
+
+	for _, v := range items {
+		if v.IsArchived {
+			continue
+		}
+
+		statuses.Items = append(statuses.Items, itemStatus(v, field))
+	}
+
+	return statuses, nil
+}
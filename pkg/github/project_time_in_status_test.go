@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestProjectTimeInStatusDataframe(t *testing.T) {
+	now, err := time.Parse(time.RFC3339, "2020-09-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inProgress := ProjectItem{
+		ID:        "PVTI_1",
+		Type:      ProjectItemTypeIssue,
+		UpdatedAt: githubv4.DateTime{Time: now.Add(-24 * time.Hour)},
+	}
+	inProgress.Content.Issue.Number = 1
+	inProgress.Content.Issue.Title = "Issue #1"
+	inProgress.Content.Issue.Repository.NameWithOwner = "grafana/grafana"
+	status := singleSelectFieldValue("Workflow", "In Progress")
+	status.SingleSelect.UpdatedAt = githubv4.DateTime{Time: now.Add(-10 * 24 * time.Hour)}
+	inProgress.FieldValues.Nodes = []ProjectV2ItemFieldValue{status}
+
+	// The time the status was set is not known, so the time the item was updated is used
+	done := ProjectItem{
+		ID:        "PVTI_2",
+		Type:      ProjectItemTypePullRequest,
+		UpdatedAt: githubv4.DateTime{Time: now.Add(-3 * 24 * time.Hour)},
+	}
+	done.Content.PullRequest.Number = 2
+	done.Content.PullRequest.Title = "PullRequest #2"
+	done.Content.PullRequest.Repository.NameWithOwner = "grafana/grafana"
+	done.FieldValues.Nodes = []ProjectV2ItemFieldValue{singleSelectFieldValue("Workflow", "Done")}
+
+	noStatus := ProjectItem{
+		ID:        "PVTI_3",
+		Type:      ProjectItemTypeDraftIssue,
+		UpdatedAt: githubv4.DateTime{Time: now.Add(-5 * 24 * time.Hour)},
+	}
+	noStatus.Content.DraftIssue.Title = "Draft"
+
+	archived := ProjectItem{
+		ID:         "PVTI_4",
+		Type:       ProjectItemTypeIssue,
+		IsArchived: true,
+	}
+
+	client := &projectItemsClient{
+		items: ProjectItems{inProgress, done, noStatus, archived},
+	}
+
+	opts := models.ListProjectTimeInStatusOptions{
+		Owner:       "grafana",
+		Number:      1,
+		StatusField: "Workflow",
+	}
+
+	statuses, err := GetProjectTimeInStatus(context.Background(), client, opts, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testutil.CheckGoldenFramer("project_time_in_status", statuses); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,439 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// Project is a GitHub Project (v2) of an organization
+type Project struct {
+	Number    int64
+	Title     string
+	URL       string
+	Public    bool
+	Closed    bool
+	UpdatedAt githubv4.DateTime
+	Items     struct {
+		TotalCount int64
+	}
+}
+
+// Projects is a list of GitHub Projects (v2)
+type Projects []Project
+
+// Frames converts the list of projects to a Grafana DataFrame
+func (p Projects) Frames() data.Frames {
+	frame := data.NewFrame(
+		"projects",
+		data.NewField("number", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("public", nil, []bool{}),
+		data.NewField("closed", nil, []bool{}),
+		data.NewField("items", nil, []int64{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+	)
+
+	for _, v := range p {
+		frame.AppendRow(
+			v.Number,
+			v.Title,
+			v.URL,
+			v.Public,
+			v.Closed,
+			v.Items.TotalCount,
+			v.UpdatedAt.Time,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QueryListProjects is the GraphQL query for listing the GitHub Projects (v2) of an organization
+// {
+//   organization(login: "grafana") {
+//     projectsV2(first: 100, query: "roadmap") {
+//       nodes {
+//         number
+//         title
+//         items {
+//           totalCount
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryListProjects struct {
+	Organization struct {
+		ProjectsV2 struct {
+			Nodes    Projects
+			PageInfo PageInfo
+		} `graphql:"projectsV2(first: 100, after: $cursor, query: $query)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+// GetAllProjects lists the GitHub Projects (v2) of an organization whose title matches the Query option
+func GetAllProjects(ctx context.Context, client Client, opts models.ListProjectsOptions) (Projects, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"owner":  githubv4.String(opts.Owner),
+			"query":  githubv4.String(opts.Query),
+		}
+
+		projects = Projects{}
+	)
+
+	for {
+		q := &QueryListProjects{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		projects = append(projects, q.Organization.ProjectsV2.Nodes...)
+
+		if !q.Organization.ProjectsV2.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Organization.ProjectsV2.PageInfo.EndCursor
+	}
+
+	return projects, nil
+}
+
+// ProjectV2FieldName is the configuration of a field in a project. Every type of field implements `ProjectV2FieldCommon`, which has the name of the field
+type ProjectV2FieldName struct {
+	Common struct {
+		Name string
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
+
+// ProjectV2ItemFieldValue is the value of a single field of a project item.
+// Only one of the graphql object expansions will be set, which is determined by the `Typename`.
+type ProjectV2ItemFieldValue struct {
+	Typename string `graphql:"__typename"`
+	Number   struct {
+		Number float64
+		Field  ProjectV2FieldName
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	Date struct {
+		Date  string
+		Field ProjectV2FieldName
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+	SingleSelect struct {
+		Name  string
+		Field ProjectV2FieldName
+
+		// UpdatedAt is the last time that the option of the field was changed
+		UpdatedAt githubv4.DateTime
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+}
+
+// These are the GraphQL typenames of the project item field values that are supported
+const (
+	ProjectV2ItemFieldNumberValue       = "ProjectV2ItemFieldNumberValue"
+	ProjectV2ItemFieldDateValue         = "ProjectV2ItemFieldDateValue"
+	ProjectV2ItemFieldSingleSelectValue = "ProjectV2ItemFieldSingleSelectValue"
+)
+
+// FieldName returns the name of the project field that this value belongs to
+func (v ProjectV2ItemFieldValue) FieldName() string {
+	switch v.Typename {
+	case ProjectV2ItemFieldNumberValue:
+		return v.Number.Field.Common.Name
+	case ProjectV2ItemFieldDateValue:
+		return v.Date.Field.Common.Name
+	case ProjectV2ItemFieldSingleSelectValue:
+		return v.SingleSelect.Field.Common.Name
+	}
+
+	return ""
+}
+
+// ProjectItem is a single item (an issue, pull request, or draft issue) in a GitHub Project (v2)
+type ProjectItem struct {
+	ID         string
+	Type       string
+	IsArchived bool
+	CreatedAt  githubv4.DateTime
+	UpdatedAt  githubv4.DateTime
+	Content    struct {
+		Issue       Issue `graphql:"... on Issue"`
+		PullRequest Issue `graphql:"... on PullRequest"`
+		DraftIssue  struct {
+			Title string
+		} `graphql:"... on DraftIssue"`
+	}
+	FieldValues struct {
+		Nodes []ProjectV2ItemFieldValue
+	} `graphql:"fieldValues(first: 100)"`
+}
+
+// These are the possible values of a project item's type
+const (
+	ProjectItemTypeIssue       = "ISSUE"
+	ProjectItemTypePullRequest = "PULL_REQUEST"
+	ProjectItemTypeDraftIssue  = "DRAFT_ISSUE"
+)
+
+// Title returns the title of the issue, pull request, or draft issue in the project item
+func (p ProjectItem) Title() string {
+	switch p.Type {
+	case ProjectItemTypeIssue:
+		return p.Content.Issue.Title
+	case ProjectItemTypePullRequest:
+		return p.Content.PullRequest.Title
+	}
+
+	return p.Content.DraftIssue.Title
+}
+
+// Number returns the issue or pull request number of the project item. Draft issues do not have a number.
+func (p ProjectItem) Number() *int64 {
+	var n int64
+	switch p.Type {
+	case ProjectItemTypeIssue:
+		n = p.Content.Issue.Number
+	case ProjectItemTypePullRequest:
+		n = p.Content.PullRequest.Number
+	default:
+		return nil
+	}
+
+	return &n
+}
+
+// Repository returns the repository of the issue or pull request in the project item. Draft issues do not have a repository.
+func (p ProjectItem) Repository() string {
+	switch p.Type {
+	case ProjectItemTypeIssue:
+		return p.Content.Issue.Repository.NameWithOwner
+	case ProjectItemTypePullRequest:
+		return p.Content.PullRequest.Repository.NameWithOwner
+	}
+
+	return ""
+}
+
+// FieldValue returns the value of the project field with the given name, or nil if the item does not have a value for that field
+func (p ProjectItem) FieldValue(name string) *ProjectV2ItemFieldValue {
+	for i, v := range p.FieldValues.Nodes {
+		if v.FieldName() == name {
+			return &p.FieldValues.Nodes[i]
+		}
+	}
+
+	return nil
+}
+
+// ProjectItems is a list of GitHub Project (v2) items
+type ProjectItems []ProjectItem
+
+// Frames converts the list of project items to a Grafana DataFrame
+func (p ProjectItems) Frames() data.Frames {
+	return ProjectItemsWrapper{Items: p}.Frames()
+}
+
+// ProjectItemsWrapper is a list of GitHub Project (v2) items along with the query options that change how they are converted to a data frame
+type ProjectItemsWrapper struct {
+	Items   ProjectItems
+	Options models.ListProjectItemsOptions
+}
+
+// Frames converts the list of project items to a Grafana DataFrame. Every field in the options' list of Fields is added as a column.
+func (w ProjectItemsWrapper) Frames() data.Frames {
+	frame := data.NewFrame(
+		"project_items",
+		data.NewField("id", nil, []string{}),
+		data.NewField("type", nil, []string{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("number", nil, []*int64{}),
+		data.NewField("repo", nil, []string{}),
+		data.NewField("archived", nil, []bool{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+	)
+
+	for _, v := range w.Items {
+		frame.AppendRow(
+			v.ID,
+			v.Type,
+			v.Title(),
+			v.Number(),
+			v.Repository(),
+			v.IsArchived,
+			v.CreatedAt.Time,
+			v.UpdatedAt.Time,
+		)
+	}
+
+	for _, name := range w.Options.Fields {
+		frame.Fields = append(frame.Fields, w.Items.fieldColumn(name))
+	}
+
+	return data.Frames{frame}
+}
+
+// fieldColumn creates a data frame field with the values of the project field for every item.
+// The type of the column is determined by the first item that has a value for the project field.
+func (p ProjectItems) fieldColumn(name string) *data.Field {
+	typename := ""
+	for _, v := range p {
+		if value := v.FieldValue(name); value != nil {
+			typename = value.Typename
+			break
+		}
+	}
+
+	switch typename {
+	case ProjectV2ItemFieldNumberValue:
+		values := make([]*float64, len(p))
+		for i, v := range p {
+			if value := v.FieldValue(name); value != nil && value.Typename == typename {
+				n := value.Number.Number
+				values[i] = &n
+			}
+		}
+		return data.NewField(name, nil, values)
+	case ProjectV2ItemFieldDateValue:
+		values := make([]*time.Time, len(p))
+		for i, v := range p {
+			if value := v.FieldValue(name); value != nil && value.Typename == typename {
+				if t, err := time.Parse("2006-01-02", value.Date.Date); err == nil {
+					values[i] = &t
+				}
+			}
+		}
+		return data.NewField(name, nil, values)
+	}
+
+	values := make([]*string, len(p))
+	for i, v := range p {
+		if value := v.FieldValue(name); value != nil && value.Typename == ProjectV2ItemFieldSingleSelectValue {
+			s := value.SingleSelect.Name
+			values[i] = &s
+		}
+	}
+
+	return data.NewField(name, nil, values)
+}
+
+// QueryListProjectItems is the GraphQL query for listing the items in an organization's GitHub Project (v2)
+// {
+//   organization(login: "grafana") {
+//     projectV2(number: 1) {
+//       items(first: 100) {
+//         nodes {
+//           id
+//           type
+//           content {
+//             ... on Issue {
+//               number
+//               title
+//             }
+//           }
+//           fieldValues(first: 100) {
+//             nodes {
+//               ... on ProjectV2ItemFieldNumberValue {
+//                 number
+//                 field {
+//                   ... on ProjectV2FieldCommon {
+//                     name
+//                   }
+//                 }
+//               }
+//             }
+//           }
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryListProjectItems struct {
+	Organization struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []ProjectItem
+				PageInfo PageInfo
+			} `graphql:"items(first: 100, after: $cursor)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+// GetAllProjectItems lists every item in an organization's GitHub Project (v2)
+func GetAllProjectItems(ctx context.Context, client Client, opts models.ListProjectItemsOptions) (ProjectItems, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"owner":  githubv4.String(opts.Owner),
+			"number": githubv4.Int(opts.Number),
+			// The descriptions of the issues and pull requests are not used
+			"includeBody":          githubv4.Boolean(false),
+			"includeReopenedCount": githubv4.Boolean(false),
+		}
+
+		items = ProjectItems{}
+	)
+
+	for {
+		q := &QueryListProjectItems{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		items = append(items, q.Organization.ProjectV2.Items.Nodes...)
+
+		if !q.Organization.ProjectV2.Items.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Organization.ProjectV2.Items.PageInfo.EndCursor
+	}
+
+	return items, nil
+}
+
+// GetProjectIssuesByStatus lists the issues (and optionally the pull requests) in an organization's GitHub Project (v2) whose status field has the given value.
+// Archived items and draft issues are skipped.
+func GetProjectIssuesByStatus(ctx context.Context, client Client, opts models.ListProjectIssuesOptions) (Issues, error) {
+	items, err := GetAllProjectItems(ctx, client, models.ListProjectItemsOptions{
+		Owner:  opts.Owner,
+		Number: opts.Number,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	field := opts.StatusField
+	if field == "" {
+		field = models.DefaultProjectStatusField
+	}
+
+	issues := Issues{}
+	for _, v := range items {
+		if v.IsArchived {
+			continue
+		}
+
+		value := v.FieldValue(field)
+		if value == nil || value.Typename != ProjectV2ItemFieldSingleSelectValue || !strings.EqualFold(value.SingleSelect.Name, opts.Status) {
+			continue
+		}
+
+		switch v.Type {
+		case ProjectItemTypeIssue:
+			issues = append(issues, v.Content.Issue)
+		case ProjectItemTypePullRequest:
+			if opts.IncludePullRequests {
+				issues = append(issues, v.Content.PullRequest)
+			}
+		}
+	}
+
+	return issues, nil
+}
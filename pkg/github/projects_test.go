@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetAllProjects(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("cursor", "owner", "query"),
+		testutil.GetTestQueryFunction(&QueryListProjects{}),
+	)
+
+	_, err := GetAllProjects(context.Background(), client, models.ListProjectsOptions{Owner: "grafana", Query: "roadmap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProjectsDataframe(t *testing.T) {
+	updatedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projects := Projects{
+		{
+			Number:    1,
+			Title:     "Roadmap",
+			URL:       "https://github.com/orgs/grafana/projects/1",
+			Public:    true,
+			UpdatedAt: githubv4.DateTime{Time: updatedAt},
+		},
+		{
+			Number:    7,
+			Title:     "Old sprint board",
+			URL:       "https://github.com/orgs/grafana/projects/7",
+			Closed:    true,
+			UpdatedAt: githubv4.DateTime{Time: updatedAt.Add(-30 * 24 * time.Hour)},
+		},
+	}
+	projects[0].Items.TotalCount = 42
+	projects[1].Items.TotalCount = 5
+
+	if err := testutil.CheckGoldenFramer("projects", projects); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAllProjectItems(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListProjectItemsOptions{
+			Owner:  "grafana",
+			Number: 1,
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("cursor", "owner", "number")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryListProjectItems{}),
+	)
+
+	_, err := GetAllProjectItems(ctx, client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func numberFieldValue(name string, n float64) ProjectV2ItemFieldValue {
+	v := ProjectV2ItemFieldValue{Typename: ProjectV2ItemFieldNumberValue}
+	v.Number.Number = n
+	v.Number.Field.Common.Name = name
+	return v
+}
+
+func dateFieldValue(name string, date string) ProjectV2ItemFieldValue {
+	v := ProjectV2ItemFieldValue{Typename: ProjectV2ItemFieldDateValue}
+	v.Date.Date = date
+	v.Date.Field.Common.Name = name
+	return v
+}
+
+func singleSelectFieldValue(name string, option string) ProjectV2ItemFieldValue {
+	v := ProjectV2ItemFieldValue{Typename: ProjectV2ItemFieldSingleSelectValue}
+	v.SingleSelect.Name = option
+	v.SingleSelect.Field.Common.Name = name
+	return v
+}
+
+func TestProjectItemsDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue := ProjectItem{
+		ID:        "PVTI_1",
+		Type:      ProjectItemTypeIssue,
+		CreatedAt: githubv4.DateTime{Time: createdAt},
+		UpdatedAt: githubv4.DateTime{Time: createdAt.Add(time.Hour)},
+	}
+	issue.Content.Issue = Issue{
+		Number: 1,
+		Title:  "Issue #1",
+		Repository: Repository{
+			NameWithOwner: "grafana/grafana",
+		},
+	}
+	issue.FieldValues.Nodes = []ProjectV2ItemFieldValue{
+		numberFieldValue("Story Points", 3),
+		dateFieldValue("Target Date", "2020-09-01"),
+		singleSelectFieldValue("Status", "In Progress"),
+	}
+
+	pr := ProjectItem{
+		ID:        "PVTI_2",
+		Type:      ProjectItemTypePullRequest,
+		CreatedAt: githubv4.DateTime{Time: createdAt},
+		UpdatedAt: githubv4.DateTime{Time: createdAt.Add(2 * time.Hour)},
+	}
+	pr.Content.PullRequest.Number = 2
+	pr.Content.PullRequest.Title = "PullRequest #2"
+	pr.Content.PullRequest.Repository.NameWithOwner = "grafana/grafana"
+	pr.FieldValues.Nodes = []ProjectV2ItemFieldValue{
+		singleSelectFieldValue("Status", "Done"),
+	}
+
+	draft := ProjectItem{
+		ID:         "PVTI_3",
+		Type:       ProjectItemTypeDraftIssue,
+		IsArchived: true,
+		CreatedAt:  githubv4.DateTime{Time: createdAt},
+		UpdatedAt:  githubv4.DateTime{Time: createdAt},
+	}
+	draft.Content.DraftIssue.Title = "Draft issue"
+	draft.FieldValues.Nodes = []ProjectV2ItemFieldValue{
+		numberFieldValue("Story Points", 8),
+	}
+
+	items := ProjectItemsWrapper{
+		Items: ProjectItems{issue, pr, draft},
+		Options: models.ListProjectItemsOptions{
+			Fields: []string{"Story Points", "Target Date", "Status", "Does Not Exist"},
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("project_items", items); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// projectItemsClient responds to the project items query with a single page of items
+type projectItemsClient struct {
+	items ProjectItems
+}
+
+func (c *projectItemsClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	q.(*QueryListProjectItems).Organization.ProjectV2.Items.Nodes = c.items
+	return nil
+}
+
+func TestGetProjectIssuesByStatus(t *testing.T) {
+	newItem := func(itemType string, number int64, status string, archived bool) ProjectItem {
+		item := ProjectItem{
+			Type:       itemType,
+			IsArchived: archived,
+		}
+		item.Content.Issue.Number = number
+		item.Content.PullRequest.Number = number
+		item.FieldValues.Nodes = []ProjectV2ItemFieldValue{
+			singleSelectFieldValue("Status", status),
+		}
+		return item
+	}
+
+	client := &projectItemsClient{
+		items: ProjectItems{
+			newItem(ProjectItemTypeIssue, 1, "In Progress", false),
+			newItem(ProjectItemTypeIssue, 2, "Done", false),
+			newItem(ProjectItemTypeIssue, 3, "in progress", true),
+			newItem(ProjectItemTypePullRequest, 4, "In Progress", false),
+			newItem(ProjectItemTypeDraftIssue, 0, "In Progress", false),
+			newItem(ProjectItemTypeIssue, 5, "in progress", false),
+		},
+	}
+
+	numbers := func(issues Issues) []int64 {
+		n := []int64{}
+		for _, v := range issues {
+			n = append(n, v.Number)
+		}
+		return n
+	}
+
+	t.Run("only the issues with the status are returned", func(t *testing.T) {
+		issues, err := GetProjectIssuesByStatus(context.Background(), client, models.ListProjectIssuesOptions{
+			Status: "In Progress",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n := numbers(issues); len(n) != 2 || n[0] != 1 || n[1] != 5 {
+			t.Fatalf("Expected issues 1 and 5, received %v", n)
+		}
+	})
+
+	t.Run("pull requests are returned if they are included", func(t *testing.T) {
+		issues, err := GetProjectIssuesByStatus(context.Background(), client, models.ListProjectIssuesOptions{
+			Status:              "In Progress",
+			IncludePullRequests: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n := numbers(issues); len(n) != 3 || n[0] != 1 || n[1] != 4 || n[2] != 5 {
+			t.Fatalf("Expected issues 1, 4, and 5, received %v", n)
+		}
+	})
+
+	t.Run("a custom status field can be used", func(t *testing.T) {
+		issues, err := GetProjectIssuesByStatus(context.Background(), client, models.ListProjectIssuesOptions{
+			StatusField: "Stage",
+			Status:      "In Progress",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(issues) != 0 {
+			t.Fatalf("Expected no issues, received %v", numbers(issues))
+		}
+	})
+}
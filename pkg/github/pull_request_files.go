@@ -0,0 +1,100 @@
+package github
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// PullRequestFile is a file that was changed in a pull request
+type PullRequestFile struct {
+	Path       string
+	Additions  int64
+	Deletions  int64
+	ChangeType string
+}
+
+// PullRequestFiles is a list of files changed in a pull request
+type PullRequestFiles []PullRequestFile
+
+// Frames converts the list of changed files to a Grafana DataFrame
+func (f PullRequestFiles) Frames() data.Frames {
+	frame := data.NewFrame(
+		"pull_request_files",
+		data.NewField("path", nil, []string{}),
+		data.NewField("additions", nil, []int64{}),
+		data.NewField("deletions", nil, []int64{}),
+		data.NewField("change_type", nil, []string{}),
+	)
+
+	for _, v := range f {
+		frame.AppendRow(
+			v.Path,
+			v.Additions,
+			v.Deletions,
+			v.ChangeType,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QueryListPullRequestFiles is the GraphQL query for listing the files changed in a pull request
+// {
+//   repository(name: "grafana", owner: "grafana") {
+//     pullRequest(number: 27612) {
+//       files(first: 100) {
+//         nodes {
+//           path
+//           additions
+//           deletions
+//           changeType
+//         }
+//       }
+//     }
+//   }
+// }
+type QueryListPullRequestFiles struct {
+	Repository struct {
+		PullRequest struct {
+			Files struct {
+				Nodes    PullRequestFiles
+				PageInfo PageInfo
+			} `graphql:"files(first: 100, after: $cursor)"`
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// GetPullRequestFiles lists the files changed in a pull request.
+// At most PullRequestFilesPageLimit pages are traversed, so the list may be incomplete for very large pull requests.
+func GetPullRequestFiles(ctx context.Context, client Client, opts models.ListPullRequestFilesOptions) (PullRequestFiles, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"name":   githubv4.String(opts.Repository),
+			"owner":  githubv4.String(opts.Owner),
+			"number": githubv4.Int(opts.Number),
+		}
+
+		files = PullRequestFiles{}
+	)
+
+	for i := 0; i < PullRequestFilesPageLimit; i++ {
+		q := &QueryListPullRequestFiles{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		files = append(files, q.Repository.PullRequest.Files.Nodes...)
+
+		if !q.Repository.PullRequest.Files.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Repository.PullRequest.Files.PageInfo.EndCursor
+	}
+
+	return files, nil
+}
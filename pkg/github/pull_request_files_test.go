@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+func TestGetPullRequestFiles(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListPullRequestFilesOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+			Number:     27612,
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("cursor", "name", "owner", "number")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QueryListPullRequestFiles{}),
+	)
+
+	_, err := GetPullRequestFiles(ctx, client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPullRequestFilesDataframe(t *testing.T) {
+	files := PullRequestFiles{
+		PullRequestFile{
+			Path:       "pkg/api/dashboard.go",
+			Additions:  42,
+			Deletions:  7,
+			ChangeType: "MODIFIED",
+		},
+		PullRequestFile{
+			Path:       "pkg/api/dashboard_test.go",
+			Additions:  120,
+			Deletions:  0,
+			ChangeType: "ADDED",
+		},
+		PullRequestFile{
+			Path:       "pkg/api/legacy.go",
+			Additions:  0,
+			Deletions:  310,
+			ChangeType: "DELETED",
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_request_files", files); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,22 +28,18 @@ type QueryListPullRequests struct {
 		Nodes []struct {
 			PullRequest PullRequest `graphql:"... on PullRequest"`
 		}
-		PageInfo PageInfo
+		PageInfo   PageInfo
+		IssueCount int64
 	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
 }
 
-// PullRequestAuthor is the structure of the Author object in a Pull Request (which requires a grapql object expansion on `User`)
-type PullRequestAuthor struct {
-	User User `graphql:"... on User"`
-}
-
 // PullRequest is a GitHub pull request
 type PullRequest struct {
 	Number     int64
 	Title      string
 	URL        string
 	State      githubv4.PullRequestState
-	Author     PullRequestAuthor
+	Author     Author `graphql:"author @include(if: $includeAuthor)"`
 	Closed     bool
 	IsDraft    bool
 	Locked     bool
@@ -52,8 +49,87 @@ type PullRequest struct {
 	UpdatedAt  githubv4.DateTime
 	MergedAt   githubv4.DateTime
 	Mergeable  githubv4.MergeableState
-	MergedBy   *PullRequestAuthor
-	Repository Repository
+	MergedBy   *Author    `graphql:"mergedBy @include(if: $includeAuthor)"`
+	Repository Repository `graphql:"repository @include(if: $includeRepository)"`
+	Comments   struct {
+		TotalCount int64
+	} `graphql:"comments @include(if: $includeComments)"`
+	ReviewThreads struct {
+		TotalCount int64
+	} `graphql:"reviewThreads @include(if: $includeReviewThreads)"`
+
+	// Reviews are the first submitted reviews of the pull request. They are only part of the query if the includeFirstReview variable is true.
+	// More than one review is selected, because the comments of the author on their own pull request are reviews too
+	Reviews struct {
+		Nodes []PullRequestReview
+	} `graphql:"reviews(first: 10, states: [APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED]) @include(if: $includeFirstReview)"`
+
+	// Body is the plain text description of the pull request. It is only part of the query if the includeBody variable is true
+	Body string `graphql:"bodyText @include(if: $includeBody)"`
+
+	// ClosingIssuesReferences are the issues that the pull request closes when it is merged. They are only part of the query if the includeClosingIssues variable is true
+	ClosingIssuesReferences struct {
+		Nodes []struct {
+			Number int64
+		}
+	} `graphql:"closingIssuesReferences(first: 5) @include(if: $includeClosingIssues)"`
+
+	// ReopenedEvents is the number of times the pull request was reopened. It is only part of the query if the includeReopenedCount variable is true
+	ReopenedEvents struct {
+		TotalCount int64
+	} `graphql:"reopenedEvents: timelineItems(itemTypes: [REOPENED_EVENT]) @include(if: $includeReopenedCount)"`
+
+	Reactable
+}
+
+// PullRequestReview is a submitted review of a pull request
+type PullRequestReview struct {
+	SubmittedAt *githubv4.DateTime
+	State       githubv4.PullRequestReviewState
+	Author      struct {
+		Login string
+	}
+}
+
+// ClosesIssues returns the comma separated numbers of the issues that the pull request closes when it is merged, or an empty string if it closes none
+func (p PullRequest) ClosesIssues() string {
+	numbers := make([]string, len(p.ClosingIssuesReferences.Nodes))
+	for i, v := range p.ClosingIssuesReferences.Nodes {
+		numbers[i] = strconv.FormatInt(v.Number, 10)
+	}
+
+	return strings.Join(numbers, ",")
+}
+
+// TimeToFirstReview returns the number of seconds between the creation of the pull request and its first submitted review.
+// Comments that the author left on their own pull request are not counted as a review.
+// It returns nil if the pull request has not been reviewed yet, or if the reviews were not part of the query.
+func (p PullRequest) TimeToFirstReview() *float64 {
+	for _, v := range p.Reviews.Nodes {
+		if v.SubmittedAt == nil {
+			continue
+		}
+
+		if v.State == githubv4.PullRequestReviewStateCommented && v.Author.Login != "" && v.Author.Login == p.Author.Login {
+			continue
+		}
+
+		s := v.SubmittedAt.UTC().Sub(p.CreatedAt.UTC()).Seconds()
+		return &s
+	}
+
+	return nil
+}
+
+// TimeToMerge returns the number of seconds between the creation of the pull request and the time it was merged.
+// It returns nil if the pull request has not been merged.
+func (p PullRequest) TimeToMerge() *float64 {
+	if p.MergedAt.IsZero() {
+		return nil
+	}
+
+	s := p.MergedAt.UTC().Sub(p.CreatedAt.UTC()).Seconds()
+	return &s
 }
 
 // PullRequests is a list of GitHub Pull Requests
@@ -61,6 +137,17 @@ type PullRequests []PullRequest
 
 // Frames converts the list of Pull Requests to a Grafana DataFrame
 func (p PullRequests) Frames() data.Frames {
+	return PullRequestsWrapper{PullRequests: p}.Frames()
+}
+
+// PullRequestsWrapper is a list of GitHub Pull Requests along with the query options that change how they are converted to a data frame
+type PullRequestsWrapper struct {
+	PullRequests PullRequests
+	Options      models.ListPullRequestsOptions
+}
+
+// Frames converts the list of Pull Requests to a Grafana DataFrame. If the Fields option is set, only those columns are included
+func (w PullRequestsWrapper) Frames() data.Frames {
 	openTime := data.NewField("open_time", nil, []float64{})
 	openTime.Config = &data.FieldConfig{
 		Unit: "s", // The values are in seconds
@@ -76,11 +163,14 @@ func (p PullRequests) Frames() data.Frames {
 		data.NewField("author_login", nil, []string{}),
 		data.NewField("author_email", nil, []string{}),
 		data.NewField("author_company", nil, []string{}),
+		data.NewField("author_type", nil, []string{}),
 		data.NewField("closed", nil, []bool{}),
 		data.NewField("is_draft", nil, []bool{}),
 		data.NewField("locked", nil, []bool{}),
 		data.NewField("merged", nil, []bool{}),
 		data.NewField("mergeable", nil, []string{}),
+		data.NewField("comments", nil, []int64{}),
+		data.NewField("review_threads", nil, []int64{}),
 		data.NewField("closed_at", nil, []*time.Time{}),
 		data.NewField("merged_at", nil, []*time.Time{}),
 		data.NewField("updated_at", nil, []time.Time{}),
@@ -88,7 +178,31 @@ func (p PullRequests) Frames() data.Frames {
 		openTime,
 	)
 
-	for _, v := range p {
+	if w.Options.IncludeBody {
+		frame.Fields = append(frame.Fields, data.NewField("body", nil, []string{}))
+	}
+
+	if w.Options.IncludeClosingIssues {
+		frame.Fields = append(frame.Fields, data.NewField("closes_issues", nil, []string{}))
+	}
+
+	if w.Options.IncludeReactions {
+		frame.Fields = append(frame.Fields, reactionFields()...)
+	}
+
+	if w.Options.IncludeReopenedCount {
+		frame.Fields = append(frame.Fields, data.NewField("reopened_count", nil, []int64{}))
+	}
+
+	if w.Options.IncludeFirstReview {
+		timeToFirstReview := data.NewField("time_to_first_review_seconds", nil, []*float64{})
+		timeToFirstReview.Config = &data.FieldConfig{
+			Unit: "s", // The values are in seconds
+		}
+		frame.Fields = append(frame.Fields, timeToFirstReview)
+	}
+
+	for _, v := range w.PullRequests {
 		var (
 			closedAt    *time.Time
 			mergedAt    *time.Time
@@ -113,33 +227,99 @@ func (p PullRequests) Frames() data.Frames {
 			secondsOpen = v.ClosedAt.UTC().Sub(v.MergedAt.UTC()).Seconds()
 		}
 
-		frame.AppendRow(
+		values := []interface{}{
 			v.Number,
 			v.Title,
 			v.URL,
 			v.Repository.NameWithOwner,
 			string(v.State),
-			v.Author.User.Login,
+			v.Author.Login,
 			v.Author.User.Email,
 			v.Author.User.Company,
+			v.Author.Typename,
 			v.Closed,
 			v.IsDraft,
 			v.Locked,
 			v.Merged,
 			string(v.Mergeable),
+			v.Comments.TotalCount,
+			v.ReviewThreads.TotalCount,
 			closedAt,
 			mergedAt,
 			v.UpdatedAt.Time,
 			v.CreatedAt.Time,
 			secondsOpen,
-		)
+		}
+
+		if w.Options.IncludeBody {
+			values = append(values, truncateBody(v.Body, w.Options.BodyMaxLength))
+		}
+
+		if w.Options.IncludeClosingIssues {
+			values = append(values, v.ClosesIssues())
+		}
+
+		if w.Options.IncludeReactions {
+			values = append(values, v.ReactionValues()...)
+		}
+
+		if w.Options.IncludeReopenedCount {
+			values = append(values, v.ReopenedEvents.TotalCount)
+		}
+
+		if w.Options.IncludeFirstReview {
+			values = append(values, v.TimeToFirstReview())
+		}
+
+		frame.AppendRow(values...)
+	}
+
+	if len(w.Options.Fields) > 0 {
+		frame.Fields = selectFields(frame.Fields, w.Options.Fields)
 	}
 
 	return data.Frames{frame}
 }
 
+// pullRequestFieldSelections maps the GraphQL variables that toggle the optional parts of the pull request selection to the columns that need them
+var pullRequestFieldSelections = map[string][]string{
+	"includeAuthor":        {"author_login", "author_email", "author_company", "author_type"},
+	"includeRepository":    {"repository"},
+	"includeComments":      {"comments"},
+	"includeReviewThreads": {"review_threads"},
+}
+
+// pullRequestFieldVariables returns the GraphQL variables that include or skip the optional parts of the pull request selection.
+// Everything is selected if no fields are set, so that every column can be filled.
+func pullRequestFieldVariables(opts models.ListPullRequestsOptions) map[string]interface{} {
+	variables := map[string]interface{}{}
+	for variable, columns := range pullRequestFieldSelections {
+		variables[variable] = githubv4.Boolean(len(opts.Fields) == 0 || containsAny(opts.Fields, columns))
+	}
+
+	// The author is needed to remove the pull requests opened by bots, even if none of the author columns are returned
+	if opts.ExcludeBots {
+		variables["includeAuthor"] = githubv4.Boolean(true)
+	}
+
+	return variables
+}
+
+// optInFieldVariable returns the GraphQL variable that includes a part of the selection that is only needed for columns that have to be requested, like the description of the pull requests.
+// Unlike the other optional parts of the selection, these are skipped by default, because they are large or expensive for GitHub to resolve
+func optInFieldVariable(requested bool, fields []string, columns ...string) githubv4.Boolean {
+	return githubv4.Boolean(requested && (len(fields) == 0 || containsAny(fields, columns)))
+}
+
 // GetAllPullRequests uses the graphql search endpoint API to search all pull requests in the repository
 func GetAllPullRequests(ctx context.Context, client Client, opts models.ListPullRequestsOptions) (PullRequests, error) {
+	pullRequests, _, err := searchPullRequests(ctx, client, opts)
+	return pullRequests, err
+}
+
+// searchPullRequests lists the pull requests like GetAllPullRequests.
+// It also returns the number of pull requests that match the search, which is more than the number of pull requests returned if the search exceeds SearchResultLimit
+func searchPullRequests(ctx context.Context, client Client, opts models.ListPullRequestsOptions) (PullRequests, int64, error) {
 	var (
 		variables = map[string]interface{}{
 			"cursor": (*githubv4.String)(nil),
@@ -147,19 +327,39 @@ func GetAllPullRequests(ctx context.Context, client Client, opts models.ListPull
 		}
 
 		pullRequests = []PullRequest{}
+		count        int64
 	)
 
+	for k, v := range pullRequestFieldVariables(opts) {
+		variables[k] = v
+	}
+	variables["includeBody"] = optInFieldVariable(opts.IncludeBody, opts.Fields, "body")
+	variables["includeClosingIssues"] = optInFieldVariable(opts.IncludeClosingIssues, opts.Fields, "closes_issues")
+	variables["includeReactions"] = optInFieldVariable(opts.IncludeReactions, opts.Fields, reactionColumns()...)
+	variables["includeReopenedCount"] = optInFieldVariable(opts.IncludeReopenedCount, opts.Fields, "reopened_count")
+	variables["includeFirstReview"] = optInFieldVariable(opts.IncludeFirstReview, opts.Fields, "time_to_first_review_seconds")
+
+	// The author is needed to skip the comments of the author on their own pull request when looking for the first review
+	if variables["includeFirstReview"] == githubv4.Boolean(true) {
+		variables["includeAuthor"] = githubv4.Boolean(true)
+	}
+
 	for {
 		q := &QueryListPullRequests{}
 		if err := client.Query(ctx, q, variables); err != nil {
-			return nil, errors.WithStack(err)
+			return nil, 0, errors.WithStack(err)
 		}
+		count = q.Search.IssueCount
 		prs := make([]PullRequest, len(q.Search.Nodes))
 
 		for i, v := range q.Search.Nodes {
 			prs[i] = v.PullRequest
 		}
 
+		if opts.ExcludeBots {
+			prs = filterBotPullRequests(prs)
+		}
+
 		pullRequests = append(pullRequests, prs...)
 
 		if !q.Search.PageInfo.HasNextPage {
@@ -168,11 +368,17 @@ func GetAllPullRequests(ctx context.Context, client Client, opts models.ListPull
 		variables["cursor"] = q.Search.PageInfo.EndCursor
 	}
 
-	return pullRequests, nil
+	return pullRequests, count, nil
 }
 
 // GetPullRequestsInRange uses the graphql search endpoint API to find pull requests in the given time range.
 func GetPullRequestsInRange(ctx context.Context, client Client, opts models.ListPullRequestsOptions, from time.Time, to time.Time) (PullRequests, error) {
+	pullRequests, _, err := searchPullRequestsInRange(ctx, client, opts, from, to)
+	return pullRequests, err
+}
+
+// searchPullRequestsInRange finds the pull requests in the time range like GetPullRequestsInRange, and also returns the number of pull requests that match the search
+func searchPullRequestsInRange(ctx context.Context, client Client, opts models.ListPullRequestsOptions, from time.Time, to time.Time) (PullRequests, int64, error) {
 	var q string
 
 	if opts.TimeField != models.PullRequestNone {
@@ -183,12 +389,36 @@ func GetPullRequestsInRange(ctx context.Context, client Client, opts models.List
 		q = fmt.Sprintf("%s %s", *opts.Query, q)
 	}
 
-	return GetAllPullRequests(ctx, client, models.ListPullRequestsOptions{
-		Repository: opts.Repository,
-		Owner:      opts.Owner,
-		TimeField:  opts.TimeField,
-		Query:      &q,
-	})
+	opts.Query = &q
+
+	return searchPullRequests(ctx, client, opts)
+}
+
+// filterBotPullRequests removes the pull requests that were opened by a bot account
+func filterBotPullRequests(prs []PullRequest) []PullRequest {
+	filtered := []PullRequest{}
+	for _, v := range prs {
+		if !v.Author.IsBot() {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
+// pullRequestStateQualifiers returns the search qualifiers that limit a search to the pull requests in the state.
+// Closed pull requests also have to be unmerged, because GitHub considers merged pull requests closed too
+func pullRequestStateQualifiers(state models.PullRequestState) []string {
+	switch state {
+	case models.PullRequestStateOpen:
+		return []string{"is:open"}
+	case models.PullRequestStateClosed:
+		return []string{"is:closed", "is:unmerged"}
+	case models.PullRequestStateMerged:
+		return []string{"is:merged"}
+	}
+
+	return nil
 }
 
 // buildQuery builds the "query" field for Pull Request searches
@@ -203,6 +433,18 @@ func buildQuery(opts models.ListPullRequestsOptions) string {
 		search = append(search, fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository))
 	}
 
+	search = append(search, labelQualifiers(opts.Labels, opts.LabelsMatch)...)
+	search = append(search, pullRequestStateQualifiers(opts.State)...)
+
+	// Without a base branch, there is no base qualifier at all, so that the pull requests that target release branches are not left out
+	if base := strings.TrimSpace(opts.BaseBranch); base != "" {
+		search = append(search, fmt.Sprintf("base:%q", base))
+	}
+
+	if q := viewerQualifier(opts.Viewer); q != "" {
+		search = append(search, q)
+	}
+
 	if opts.Query != nil {
 		search = append(search, *opts.Query)
 	}
@@ -60,8 +60,10 @@ func TestPullRequestsDataFrame(t *testing.T) {
 			Title:  "PullRequest #1",
 			URL:    "https://github.com/grafana/github-datasource/pulls/1",
 			State:  githubv4.PullRequestStateOpen,
-			Author: PullRequestAuthor{
-				User: firstUser,
+			Author: Author{
+				Typename: "User",
+				Login:    firstUser.Login,
+				User:     firstUser,
 			},
 			Repository: Repository{
 				NameWithOwner: "grafana/github-datasource",
@@ -90,8 +92,10 @@ func TestPullRequestsDataFrame(t *testing.T) {
 			Title:  "PullRequest #2",
 			URL:    "https://github.com/grafana/github-datasource/pulls/2",
 			State:  githubv4.PullRequestStateOpen,
-			Author: PullRequestAuthor{
-				User: secondUser,
+			Author: Author{
+				Typename: "User",
+				Login:    secondUser.Login,
+				User:     secondUser,
 			},
 			Repository: Repository{
 				NameWithOwner: "grafana/github-datasource",
@@ -113,8 +117,10 @@ func TestPullRequestsDataFrame(t *testing.T) {
 				Time: openedAt.Add(time.Hour * 2),
 			},
 			Mergeable: githubv4.MergeableStateMergeable,
-			MergedBy: &PullRequestAuthor{
-				User: firstUser,
+			MergedBy: &Author{
+				Typename: "User",
+				Login:    firstUser.Login,
+				User:     firstUser,
 			},
 		},
 		{
@@ -122,8 +128,10 @@ func TestPullRequestsDataFrame(t *testing.T) {
 			Title:  "PullRequest #2",
 			URL:    "https://github.com/grafana/github-datasource/pulls/3",
 			State:  githubv4.PullRequestStateOpen,
-			Author: PullRequestAuthor{
-				User: secondUser,
+			Author: Author{
+				Typename: "User",
+				Login:    secondUser.Login,
+				User:     secondUser,
 			},
 			Repository: Repository{
 				NameWithOwner: "grafana/github-datasource",
@@ -146,11 +154,323 @@ func TestPullRequestsDataFrame(t *testing.T) {
 		},
 	}
 
+	pullRequests[0].Comments.TotalCount = 12
+	pullRequests[0].ReviewThreads.TotalCount = 4
+
 	if err := testutil.CheckGoldenFramer("pull_requests", pullRequests); err != nil {
 		t.Fatal(err)
 	}
 }
 
+func TestPullRequestsSelectedFieldsDataFrame(t *testing.T) {
+	openedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pullRequests := PullRequestsWrapper{
+		PullRequests: PullRequests{
+			{
+				Number: 1,
+				Title:  "PullRequest #1",
+				State:  githubv4.PullRequestStateMerged,
+				Merged: true,
+				Closed: true,
+				CreatedAt: githubv4.DateTime{
+					Time: openedAt,
+				},
+				MergedAt: githubv4.DateTime{
+					Time: openedAt.Add(100 * time.Minute),
+				},
+				ClosedAt: githubv4.DateTime{
+					Time: openedAt.Add(100 * time.Minute),
+				},
+			},
+		},
+		Options: models.ListPullRequestsOptions{
+			Fields: []string{"state", "number", "merged_at", "does_not_exist"},
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_requests_selected_fields", pullRequests); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPullRequestFieldVariables(t *testing.T) {
+	t.Run("Every part of the query should be included if no fields are selected", func(t *testing.T) {
+		variables := pullRequestFieldVariables(models.ListPullRequestsOptions{})
+		for k, v := range variables {
+			if v != githubv4.Boolean(true) {
+				t.Errorf("Expected variable '%s' to be true", k)
+			}
+		}
+	})
+
+	t.Run("Only the parts of the query needed for the selected fields should be included", func(t *testing.T) {
+		variables := pullRequestFieldVariables(models.ListPullRequestsOptions{
+			Fields: []string{"number", "author_login"},
+		})
+		if variables["includeAuthor"] != githubv4.Boolean(true) {
+			t.Errorf("Expected the author to be included")
+		}
+		if variables["includeRepository"] != githubv4.Boolean(false) {
+			t.Errorf("Expected the repository to be skipped")
+		}
+	})
+
+	t.Run("The author should be included when excluding bots", func(t *testing.T) {
+		variables := pullRequestFieldVariables(models.ListPullRequestsOptions{
+			Fields:      []string{"number"},
+			ExcludeBots: true,
+		})
+		if variables["includeAuthor"] != githubv4.Boolean(true) {
+			t.Errorf("Expected the author to be included")
+		}
+	})
+}
+
+// pullRequestVariablesClient records the variables of the pull requests query
+type pullRequestVariablesClient struct {
+	variables map[string]interface{}
+}
+
+func (c *pullRequestVariablesClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	c.variables = variables
+	return nil
+}
+
+func TestGetAllPullRequestsFirstReview(t *testing.T) {
+	t.Run("the reviews should be skipped by default", func(t *testing.T) {
+		client := &pullRequestVariablesClient{}
+		if _, err := GetAllPullRequests(context.Background(), client, models.ListPullRequestsOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if client.variables["includeFirstReview"] != githubv4.Boolean(false) {
+			t.Errorf("Expected the reviews to be skipped")
+		}
+	})
+
+	t.Run("the reviews and the author should be included if the first review is requested", func(t *testing.T) {
+		client := &pullRequestVariablesClient{}
+		opts := models.ListPullRequestsOptions{
+			Fields:             []string{"number", "time_to_first_review_seconds"},
+			IncludeFirstReview: true,
+		}
+		if _, err := GetAllPullRequests(context.Background(), client, opts); err != nil {
+			t.Fatal(err)
+		}
+
+		if client.variables["includeFirstReview"] != githubv4.Boolean(true) || client.variables["includeAuthor"] != githubv4.Boolean(true) {
+			t.Errorf("Expected the reviews and the author to be included, received %v", client.variables)
+		}
+	})
+}
+
+func TestOptInFieldVariable(t *testing.T) {
+	if optInFieldVariable(false, nil, "body") != githubv4.Boolean(false) {
+		t.Errorf("Expected the body to be skipped if it is not requested")
+	}
+
+	if optInFieldVariable(true, nil, "body") != githubv4.Boolean(true) {
+		t.Errorf("Expected the body to be included if it is requested")
+	}
+
+	if optInFieldVariable(true, []string{"number"}, "body") != githubv4.Boolean(false) {
+		t.Errorf("Expected the body to be skipped if it is not one of the selected fields")
+	}
+
+	if optInFieldVariable(true, []string{"number", "reactions_heart"}, reactionColumns()...) != githubv4.Boolean(true) {
+		t.Errorf("Expected the reactions to be included if any of their columns is selected")
+	}
+}
+
+func TestPullRequestsWithBodyDataFrame(t *testing.T) {
+	openedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pullRequests := PullRequestsWrapper{
+		PullRequests: PullRequests{
+			{
+				Number:    1,
+				Title:     "PullRequest #1",
+				State:     githubv4.PullRequestStateOpen,
+				CreatedAt: githubv4.DateTime{Time: openedAt},
+				UpdatedAt: githubv4.DateTime{Time: openedAt},
+				MergedAt:  githubv4.DateTime{Time: openedAt},
+				ClosedAt:  githubv4.DateTime{Time: openedAt},
+				Body:      "This pull request adds a description column",
+			},
+		},
+		Options: models.ListPullRequestsOptions{
+			Fields:        []string{"number", "body"},
+			IncludeBody:   true,
+			BodyMaxLength: 25,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_requests_with_body", pullRequests); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPullRequestsWithFirstReviewDataFrame(t *testing.T) {
+	openedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	review := func(login string, state githubv4.PullRequestReviewState, minutes time.Duration) PullRequestReview {
+		r := PullRequestReview{
+			SubmittedAt: &githubv4.DateTime{Time: openedAt.Add(minutes * time.Minute)},
+			State:       state,
+		}
+		r.Author.Login = login
+		return r
+	}
+
+	pullRequests := PullRequestsWrapper{
+		PullRequests: PullRequests{
+			{
+				Number:    1,
+				Author:    Author{Login: "firstUser"},
+				CreatedAt: githubv4.DateTime{Time: openedAt},
+			},
+			{
+				Number:    2,
+				Author:    Author{Login: "firstUser"},
+				CreatedAt: githubv4.DateTime{Time: openedAt},
+			},
+			{
+				Number:    3,
+				Author:    Author{Login: "firstUser"},
+				CreatedAt: githubv4.DateTime{Time: openedAt},
+			},
+		},
+		Options: models.ListPullRequestsOptions{
+			Fields:             []string{"number", "time_to_first_review_seconds"},
+			IncludeFirstReview: true,
+		},
+	}
+
+	// The comment of the author on their own pull request is not the first review
+	pullRequests.PullRequests[1].Reviews.Nodes = []PullRequestReview{
+		review("firstUser", githubv4.PullRequestReviewStateCommented, 10),
+		review("secondUser", githubv4.PullRequestReviewStateApproved, 30),
+	}
+	// A pull request that only has comments of its author has not been reviewed yet
+	pullRequests.PullRequests[2].Reviews.Nodes = []PullRequestReview{
+		review("firstUser", githubv4.PullRequestReviewStateCommented, 10),
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_requests_first_review", pullRequests); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPullRequestsWithClosingIssuesDataFrame(t *testing.T) {
+	openedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pullRequests := PullRequestsWrapper{
+		PullRequests: PullRequests{
+			{
+				Number:    1,
+				CreatedAt: githubv4.DateTime{Time: openedAt},
+				UpdatedAt: githubv4.DateTime{Time: openedAt},
+				MergedAt:  githubv4.DateTime{Time: openedAt},
+				ClosedAt:  githubv4.DateTime{Time: openedAt},
+			},
+			{
+				Number:    2,
+				CreatedAt: githubv4.DateTime{Time: openedAt},
+				UpdatedAt: githubv4.DateTime{Time: openedAt},
+				MergedAt:  githubv4.DateTime{Time: openedAt},
+				ClosedAt:  githubv4.DateTime{Time: openedAt},
+			},
+		},
+		Options: models.ListPullRequestsOptions{
+			Fields:               []string{"number", "closes_issues"},
+			IncludeClosingIssues: true,
+		},
+	}
+
+	pullRequests.PullRequests[1].ClosingIssuesReferences.Nodes = []struct {
+		Number int64
+	}{
+		{Number: 10},
+		{Number: 14},
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_requests_closing_issues", pullRequests); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPullRequestsWithReactionsDataFrame(t *testing.T) {
+	openedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pullRequest := PullRequest{
+		Number:    1,
+		CreatedAt: githubv4.DateTime{Time: openedAt},
+		UpdatedAt: githubv4.DateTime{Time: openedAt},
+	}
+	pullRequest.Reactions.TotalCount = 5
+	pullRequest.ReactionGroups = []ReactionGroup{
+		{Content: githubv4.ReactionContentThumbsUp},
+		{Content: githubv4.ReactionContentHeart},
+		{Content: githubv4.ReactionContentRocket},
+	}
+	pullRequest.ReactionGroups[0].Reactors.TotalCount = 3
+	pullRequest.ReactionGroups[1].Reactors.TotalCount = 2
+
+	pullRequests := PullRequestsWrapper{
+		PullRequests: PullRequests{pullRequest},
+		Options: models.ListPullRequestsOptions{
+			Fields:           append([]string{"number"}, reactionColumns()...),
+			IncludeReactions: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_requests_reactions", pullRequests); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPullRequestsWithReopenedCountDataFrame(t *testing.T) {
+	openedAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pullRequest := PullRequest{
+		Number:    1,
+		CreatedAt: githubv4.DateTime{Time: openedAt},
+		UpdatedAt: githubv4.DateTime{Time: openedAt},
+	}
+	pullRequest.ReopenedEvents.TotalCount = 2
+
+	pullRequests := PullRequestsWrapper{
+		PullRequests: PullRequests{pullRequest},
+		Options: models.ListPullRequestsOptions{
+			Fields:               []string{"number", "reopened_count"},
+			IncludeReopenedCount: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("pull_requests_reopened_count", pullRequests); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestBuildQuery(t *testing.T) {
 	t.Run("Searching pull requests with a Repository and organization should use the repo field", func(t *testing.T) {
 		opts := models.ListPullRequestsOptions{
@@ -182,4 +502,86 @@ func TestBuildQuery(t *testing.T) {
 			t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
 		}
 	})
+
+	t.Run("Searching pull requests with any of the labels should use a single label qualifier", func(t *testing.T) {
+		opts := models.ListPullRequestsOptions{
+			Owner:       "grafana",
+			Repository:  "github-datasource",
+			Labels:      []string{"type/bug", "regression"},
+			LabelsMatch: models.LabelsMatchAny,
+		}
+
+		var (
+			result = buildQuery(opts)
+			expect = `is:pr repo:grafana/github-datasource label:"type/bug","regression"`
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("Searching closed pull requests should exclude the merged pull requests", func(t *testing.T) {
+		opts := models.ListPullRequestsOptions{
+			Owner:      "grafana",
+			Repository: "github-datasource",
+			State:      models.PullRequestStateClosed,
+		}
+
+		var (
+			result = buildQuery(opts)
+			expect = "is:pr repo:grafana/github-datasource is:closed is:unmerged"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("Searching pull requests in every state should not add a state qualifier", func(t *testing.T) {
+		opts := models.ListPullRequestsOptions{
+			Owner:      "grafana",
+			Repository: "github-datasource",
+			State:      models.PullRequestStateAll,
+		}
+
+		var (
+			result = buildQuery(opts)
+			expect = "is:pr repo:grafana/github-datasource"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+	t.Run("Searching pull requests with a base branch should add a base qualifier", func(t *testing.T) {
+		opts := models.ListPullRequestsOptions{
+			Owner:      "grafana",
+			Repository: "github-datasource",
+			BaseBranch: "release-7.0",
+		}
+
+		var (
+			result = buildQuery(opts)
+			expect = `is:pr repo:grafana/github-datasource base:"release-7.0"`
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("Searching pull requests without a base branch should not assume the default branch", func(t *testing.T) {
+		for _, base := range []string{"", " "} {
+			opts := models.ListPullRequestsOptions{
+				Owner:      "grafana",
+				Repository: "github-datasource",
+				BaseBranch: base,
+			}
+
+			var (
+				result = buildQuery(opts)
+				expect = "is:pr repo:grafana/github-datasource"
+			)
+			if result != expect {
+				t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
+			}
+		}
+	})
 }
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// RateLimit is the rate limit of a single GitHub API resource, like "core" (most of the REST API), "search", or "graphql"
+type RateLimit struct {
+	Resource  string `json:"-"`
+	Limit     int64  `json:"limit"`
+	Used      int64  `json:"used"`
+	Remaining int64  `json:"remaining"`
+	Reset     int64  `json:"reset"`
+}
+
+// RateLimits is a list of the rate limits of every GitHub API resource, sorted by the name of the resource
+type RateLimits []RateLimit
+
+// Frames converts the list of rate limits to a Grafana DataFrame with one row per resource
+func (r RateLimits) Frames() data.Frames {
+	frame := data.NewFrame(
+		"rate_limits",
+		data.NewField("resource", nil, []string{}),
+		data.NewField("limit", nil, []int64{}),
+		data.NewField("used", nil, []int64{}),
+		data.NewField("remaining", nil, []int64{}),
+		data.NewField("reset", nil, []time.Time{}),
+	)
+
+	for _, v := range r {
+		frame.AppendRow(
+			v.Resource,
+			v.Limit,
+			v.Used,
+			v.Remaining,
+			time.Unix(v.Reset, 0).UTC(),
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// GetRateLimits returns the rate limits of every GitHub API resource using the REST API: /rate_limit
+// Requests to this endpoint do not count against any of the rate limits.
+func GetRateLimits(ctx context.Context, client RESTClient) (RateLimits, error) {
+	res := struct {
+		Resources map[string]RateLimit `json:"resources"`
+	}{}
+	if err := client.Get(ctx, "/rate_limit", nil, &res); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	limits := RateLimits{}
+	for resource, v := range res.Resources {
+		v.Resource = resource
+		limits = append(limits, v)
+	}
+
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].Resource < limits[j].Resource
+	})
+
+	return limits, nil
+}
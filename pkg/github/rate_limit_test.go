@@ -0,0 +1,37 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+func TestGetRateLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/rate_limit" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"resources": {
+				"search": {"limit": 30, "used": 28, "remaining": 2, "reset": 1598372516},
+				"core": {"limit": 5000, "used": 12, "remaining": 4988, "reset": 1598375516},
+				"graphql": {"limit": 5000, "used": 320, "remaining": 4680, "reset": 1598374516}
+			},
+			"rate": {"limit": 5000, "used": 12, "remaining": 4988, "reset": 1598375516}
+		}`))
+	}))
+	defer srv.Close()
+
+	limits, err := GetRateLimits(context.Background(), newRESTClient(srv.Client(), srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testutil.CheckGoldenFramer("rate_limits", limits); err != nil {
+		t.Fatal(err)
+	}
+}
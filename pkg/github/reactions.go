@@ -0,0 +1,72 @@
+package github
+
+import (
+	"strings"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/shurcooL/githubv4"
+)
+
+// ReactionGroup is the number of reactions with a single emoji
+type ReactionGroup struct {
+	Content  githubv4.ReactionContent
+	Reactors struct {
+		TotalCount int64
+	}
+}
+
+// Reactable are the reactions to a pull request or discussion. They are only part of the query if the includeReactions variable is true
+type Reactable struct {
+	Reactions struct {
+		TotalCount int64
+	} `graphql:"reactions @include(if: $includeReactions)"`
+	ReactionGroups []ReactionGroup `graphql:"reactionGroups @include(if: $includeReactions)"`
+}
+
+// reactionContents are the emojis that have their own column, in the order of the columns
+var reactionContents = []githubv4.ReactionContent{
+	githubv4.ReactionContentThumbsUp,
+	githubv4.ReactionContentThumbsDown,
+	githubv4.ReactionContentLaugh,
+	githubv4.ReactionContentHooray,
+	githubv4.ReactionContentConfused,
+	githubv4.ReactionContentHeart,
+	githubv4.ReactionContentRocket,
+	githubv4.ReactionContentEyes,
+}
+
+// reactionColumns returns the name of the column with the total number of reactions, followed by the column of every emoji (ex: reactions_thumbs_up)
+func reactionColumns() []string {
+	columns := []string{"reactions"}
+	for _, v := range reactionContents {
+		columns = append(columns, "reactions_"+strings.ToLower(string(v)))
+	}
+
+	return columns
+}
+
+// reactionFields returns the fields for the reactionColumns
+func reactionFields() []*data.Field {
+	columns := reactionColumns()
+	fields := make([]*data.Field, len(columns))
+	for i, v := range columns {
+		fields[i] = data.NewField(v, nil, []int64{})
+	}
+
+	return fields
+}
+
+// ReactionValues returns the total number of reactions followed by the number of reactions with every emoji, in the order of the reactionColumns
+func (r Reactable) ReactionValues() []interface{} {
+	counts := map[githubv4.ReactionContent]int64{}
+	for _, v := range r.ReactionGroups {
+		counts[v.Content] = v.Reactors.TotalCount
+	}
+
+	values := []interface{}{r.Reactions.TotalCount}
+	for _, v := range reactionContents {
+		values = append(values, counts[v])
+	}
+
+	return values
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/grafana/github-datasource/pkg/models"
@@ -20,6 +21,25 @@ type Release struct {
 	PublishedAt  githubv4.DateTime
 	TagName      string
 	URL          string
+
+	// Mentions are the users that are mentioned in the release notes, which are the contributors that GitHub lists in generated release notes.
+	// They are only selected if the includeContributors variable is true
+	Mentions struct {
+		TotalCount int64
+		Nodes      []struct {
+			Login string
+		}
+	} `graphql:"mentions(first: 50) @include(if: $includeContributors)"`
+}
+
+// Contributors returns the logins of the users that are mentioned in the release notes, separated by commas
+func (r Release) Contributors() string {
+	logins := make([]string, len(r.Mentions.Nodes))
+	for i, v := range r.Mentions.Nodes {
+		logins[i] = v.Login
+	}
+
+	return strings.Join(logins, ",")
 }
 
 // Releases is a slice of GitHub releases
@@ -27,8 +47,25 @@ type Releases []Release
 
 // Frames converts the list of Releases to a Grafana DataFrame
 func (c Releases) Frames() data.Frames {
-	frame := data.NewFrame(
-		"releases",
+	return ReleasesWrapper{Releases: c}.Frames()
+}
+
+// ReleasesWrapper is a list of GitHub releases along with the query options that change how they are converted to a data frame
+type ReleasesWrapper struct {
+	Releases Releases
+	Options  models.ListReleasesOptions
+
+	// Location is the time zone that the buckets are aligned in (see the Timezone option). UTC is used if it is nil
+	Location *time.Location
+}
+
+// Frames converts the list of Releases to a Grafana DataFrame using the query options
+func (w ReleasesWrapper) Frames() data.Frames {
+	if w.Options.Bucket != models.BucketNone {
+		return w.bucketFrames()
+	}
+
+	fields := []*data.Field{
 		data.NewField("name", nil, []string{}),
 		data.NewField("created_by", nil, []string{}),
 		data.NewField("is_draft", nil, []bool{}),
@@ -37,16 +74,25 @@ func (c Releases) Frames() data.Frames {
 		data.NewField("url", nil, []string{}),
 		data.NewField("created_at", nil, []time.Time{}),
 		data.NewField("published_at", nil, []*time.Time{}),
-	)
+	}
+
+	if w.Options.IncludeContributors {
+		fields = append(fields,
+			data.NewField("contributors", nil, []string{}),
+			data.NewField("contributors_count", nil, []int64{}),
+		)
+	}
+
+	frame := data.NewFrame("releases", fields...)
 
-	for _, v := range c {
+	for _, v := range w.Releases {
 		var publishedAt *time.Time
 		if !v.PublishedAt.IsZero() {
 			t := v.PublishedAt.Time
 			publishedAt = &t
 		}
 
-		frame.AppendRow(
+		values := []interface{}{
 			v.Name,
 			v.Author.Login,
 			v.IsDraft,
@@ -55,12 +101,41 @@ func (c Releases) Frames() data.Frames {
 			v.URL,
 			v.CreatedAt.Time,
 			publishedAt,
-		)
+		}
+
+		if w.Options.IncludeContributors {
+			values = append(values, v.Contributors(), v.Mentions.TotalCount)
+		}
+
+		frame.AppendRow(values...)
 	}
 
 	return data.Frames{frame}
 }
 
+// bucketFrames converts the list of releases to the number of releases published in every bucket. The time column is named after the bucket interval (ex: "month")
+func (w ReleasesWrapper) bucketFrames() data.Frames {
+	times := []time.Time{}
+	for _, v := range w.Releases {
+		// Draft releases are not published yet
+		if v.PublishedAt.IsZero() {
+			continue
+		}
+
+		times = append(times, v.PublishedAt.Time)
+	}
+
+	buckets, counts := bucketCounts(times, w.Options.Bucket, w.Location)
+
+	frame := data.NewFrame(
+		"releases",
+		data.NewField(string(w.Options.Bucket), nil, buckets),
+		data.NewField("count", nil, counts),
+	)
+
+	return data.Frames{frame}
+}
+
 // QueryListReleases is the GraphQL query for listing GitHub releases in a repository
 type QueryListReleases struct {
 	Repository struct {
@@ -78,6 +153,8 @@ func GetAllReleases(ctx context.Context, client Client, opts models.ListReleases
 			"cursor": (*githubv4.String)(nil),
 			"owner":  githubv4.String(opts.Owner),
 			"name":   githubv4.String(opts.Repository),
+
+			"includeContributors": githubv4.Boolean(opts.IncludeContributors),
 		}
 
 		releases = []Release{}
@@ -95,7 +172,25 @@ func GetAllReleases(ctx context.Context, client Client, opts models.ListReleases
 		variables["cursor"] = q.Repository.Releases.PageInfo.EndCursor
 	}
 
-	return releases, nil
+	return filterReleases(releases, opts), nil
+}
+
+// filterReleases removes the draft releases and the pre-releases if they are excluded in the options
+func filterReleases(releases Releases, opts models.ListReleasesOptions) Releases {
+	if !opts.ExcludeDrafts && !opts.ExcludePrereleases {
+		return releases
+	}
+
+	filtered := Releases{}
+	for _, v := range releases {
+		if (opts.ExcludeDrafts && v.IsDraft) || (opts.ExcludePrereleases && v.IsPrerelease) {
+			continue
+		}
+
+		filtered = append(filtered, v)
+	}
+
+	return filtered
 }
 
 // GetReleasesInRange retrieves every release from the repository and then returns the ones that fall within the given time range.
@@ -19,7 +19,7 @@ func TestGetAllReleases(t *testing.T) {
 		}
 	)
 
-	testVariables := testutil.GetTestVariablesFunction("name", "owner")
+	testVariables := testutil.GetTestVariablesFunction("name", "owner", "includeContributors")
 
 	client := testutil.NewTestClient(t,
 		testVariables,
@@ -41,7 +41,7 @@ func TestListReleases(t *testing.T) {
 		}
 	)
 
-	testVariables := testutil.GetTestVariablesFunction("name", "owner")
+	testVariables := testutil.GetTestVariablesFunction("name", "owner", "includeContributors")
 
 	client := testutil.NewTestClient(t,
 		testVariables,
@@ -103,3 +103,75 @@ func TestReleasesDataFrame(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestReleasesContributorsDataFrame(t *testing.T) {
+	createdAt := githubv4.DateTime{Time: time.Date(2020, time.August, 25, 16, 21, 56, 0, time.UTC)}
+
+	release := Release{
+		Name:      "Release #1",
+		TagName:   "v1.0.0",
+		CreatedAt: createdAt,
+	}
+	release.Mentions.TotalCount = 2
+	release.Mentions.Nodes = []struct {
+		Login string
+	}{{Login: "firstContributor"}, {Login: "secondContributor"}}
+
+	releases := ReleasesWrapper{
+		Releases: Releases{release, {Name: "Release #2", TagName: "v1.1.0", CreatedAt: createdAt}},
+		Options:  models.ListReleasesOptions{IncludeContributors: true},
+	}
+
+	if err := testutil.CheckGoldenFramer("releases_contributors", releases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReleasesBucketedDataFrame(t *testing.T) {
+	published := func(year int, month time.Month, day int) githubv4.DateTime {
+		return githubv4.DateTime{Time: time.Date(year, month, day, 12, 0, 0, 0, time.UTC)}
+	}
+
+	releases := Releases{
+		{Name: "v1.0.0", PublishedAt: published(2020, time.June, 2)},
+		{Name: "v1.1.0", PublishedAt: published(2020, time.June, 20)},
+		{Name: "v1.2.0-beta", IsPrerelease: true, PublishedAt: published(2020, time.July, 1)},
+		{Name: "v1.2.0", PublishedAt: published(2020, time.August, 31)},
+		{Name: "v1.3.0", IsDraft: true},
+	}
+
+	opts := models.ListReleasesOptions{
+		ExcludeDrafts:      true,
+		ExcludePrereleases: true,
+		Bucket:             models.BucketMonth,
+	}
+
+	monthly := ReleasesWrapper{
+		Releases: filterReleases(releases, opts),
+		Options:  opts,
+	}
+
+	if err := testutil.CheckGoldenFramer("releases_bucketed_month", monthly); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFilterReleases(t *testing.T) {
+	releases := Releases{
+		{Name: "v1.0.0"},
+		{Name: "v1.1.0-beta", IsPrerelease: true},
+		{Name: "v1.1.0", IsDraft: true},
+	}
+
+	if n := len(filterReleases(releases, models.ListReleasesOptions{})); n != 3 {
+		t.Errorf("Expected every release without filters, received %d", n)
+	}
+
+	if n := len(filterReleases(releases, models.ListReleasesOptions{ExcludeDrafts: true})); n != 2 {
+		t.Errorf("Expected the draft to be removed, received %d releases", n)
+	}
+
+	if n := len(filterReleases(releases, models.ListReleasesOptions{ExcludeDrafts: true, ExcludePrereleases: true})); n != 1 {
+		t.Errorf("Expected the draft and the pre-release to be removed, received %d releases", n)
+	}
+}
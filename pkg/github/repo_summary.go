@@ -0,0 +1,244 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// RepoSummary has the open issue, open pull request, star, and fork counts of a repository
+type RepoSummary struct {
+	NameWithOwner string
+	Issues        struct {
+		TotalCount int64
+	} `graphql:"issues(states: OPEN)"`
+	PullRequests struct {
+		TotalCount int64
+	} `graphql:"pullRequests(states: OPEN)"`
+	StargazerCount int64
+	ForkCount      int64
+}
+
+// RepoSummaries is a list of repository summaries
+type RepoSummaries []RepoSummary
+
+// Frames converts the list of repository summaries to a Grafana DataFrame with one row per repository
+func (r RepoSummaries) Frames() data.Frames {
+	return RepoSummariesWrapper{Summaries: r}.Frames()
+}
+
+// RepoSummariesWrapper is a list of repository summaries along with the errors for the repositories that could not be summarized
+type RepoSummariesWrapper struct {
+	Summaries RepoSummaries
+
+	// Errors are the GraphQL errors of the repositories that are missing, like repositories that do not exist or that the token can not access
+	Errors []string
+}
+
+// Frames converts the list of repository summaries to a Grafana DataFrame with one row per repository. Every error is added as a notice
+func (w RepoSummariesWrapper) Frames() data.Frames {
+	frame := data.NewFrame(
+		"repo_summary",
+		data.NewField("repo", nil, []string{}),
+		data.NewField("open_issues", nil, []int64{}),
+		data.NewField("open_pull_requests", nil, []int64{}),
+		data.NewField("stars", nil, []int64{}),
+		data.NewField("forks", nil, []int64{}),
+	)
+	frame.Meta = partialErrorsMeta(w.Errors)
+
+	for _, v := range w.Summaries {
+		frame.AppendRow(
+			v.NameWithOwner,
+			v.Issues.TotalCount,
+			v.PullRequests.TotalCount,
+			v.StargazerCount,
+			v.ForkCount,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// repoSummaryQuery creates the GraphQL query for summarizing n repositories in a single request. Every repository is an aliased field (r0, r1, ...)
+// {
+//   r0: repository(owner: "grafana", name: "grafana") {
+//     nameWithOwner
+//     issues(states: OPEN) {
+//       totalCount
+//     }
+//   }
+//   r1: repository(owner: "grafana", name: "loki") {
+//     ...
+//   }
+// }
+func repoSummaryQuery(n int) reflect.Type {
+	fields := make([]reflect.StructField, n)
+	for i := 0; i < n; i++ {
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("R%d", i),
+			Type: reflect.TypeOf(&RepoSummary{}),
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"r%d: repository(owner: $owner%d, name: $name%d)"`, i, i, i)),
+		}
+	}
+
+	return reflect.StructOf(fields)
+}
+
+// parseRepositoryList splits the comma separated list of repositories into owners and names. Repositories without an owner belong to the default owner
+func parseRepositoryList(repositories string, owner string) [][2]string {
+	repos := [][2]string{}
+	for _, v := range strings.Split(repositories, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		if i := strings.Index(v, "/"); i != -1 {
+			repos = append(repos, [2]string{v[:i], v[i+1:]})
+			continue
+		}
+
+		repos = append(repos, [2]string{owner, v})
+	}
+
+	return repos
+}
+
+// repoSummaryLimits returns the batch size and concurrency of the options, using the defaults if they are not set and the maximums if they are too large
+func repoSummaryLimits(opts models.ListRepoSummaryOptions) (int, int) {
+	batchSize, concurrency := opts.BatchSize, opts.Concurrency
+	if batchSize <= 0 {
+		batchSize = RepoSummaryBatchSize
+	}
+	if batchSize > RepoSummaryMaxBatchSize {
+		batchSize = RepoSummaryMaxBatchSize
+	}
+
+	if concurrency <= 0 {
+		concurrency = RepoSummaryConcurrency
+	}
+	if concurrency > RepoSummaryMaxConcurrency {
+		concurrency = RepoSummaryMaxConcurrency
+	}
+
+	return batchSize, concurrency
+}
+
+// repoSummaryBatchResult is the result of summarizing one batch of repositories
+type repoSummaryBatchResult struct {
+	summaries RepoSummaries
+	messages  []string
+	err       error
+}
+
+// GetRepoSummaries summarizes every repository in the list. The repositories are batched, so that up to BatchSize repositories are summarized in a single request,
+// and up to Concurrency requests are sent in parallel. The summaries are returned in the order of the list.
+// A repository that can not be summarized does not fail the whole query. Its error is returned along with the other summaries instead.
+func GetRepoSummaries(ctx context.Context, client Client, opts models.ListRepoSummaryOptions) (RepoSummariesWrapper, error) {
+	var (
+		repos                  = parseRepositoryList(opts.Repositories, opts.Owner)
+		batchSize, concurrency = repoSummaryLimits(opts)
+		batches                = [][][2]string{}
+	)
+
+	for start := 0; start < len(repos); start += batchSize {
+		end := start + batchSize
+		if end > len(repos) {
+			end = len(repos)
+		}
+
+		batches = append(batches, repos[start:end])
+	}
+
+	// The remaining requests are cancelled as soon as one of them fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		results = make([]repoSummaryBatchResult, len(batches))
+		limit   = make(chan struct{}, concurrency)
+		wg      sync.WaitGroup
+	)
+
+	for i, batch := range batches {
+		wg.Add(1)
+		go func(i int, batch [][2]string) {
+			defer wg.Done()
+
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			if ctx.Err() != nil {
+				results[i].err = ctx.Err()
+				return
+			}
+
+			summaries, messages, err := getRepoSummaryBatch(ctx, client, batch)
+			if err != nil {
+				cancel()
+			}
+			results[i] = repoSummaryBatchResult{summaries: summaries, messages: messages, err: err}
+		}(i, batch)
+	}
+
+	wg.Wait()
+
+	summaries := RepoSummariesWrapper{
+		Summaries: RepoSummaries{},
+	}
+
+	// The first error that is not caused by the cancellation is returned
+	var err error
+	for _, v := range results {
+		if v.err != nil && (err == nil || errors.Cause(err) == context.Canceled) {
+			err = v.err
+		}
+
+		summaries.Summaries = append(summaries.Summaries, v.summaries...)
+		summaries.Errors = append(summaries.Errors, v.messages...)
+	}
+
+	if err != nil {
+		return RepoSummariesWrapper{}, err
+	}
+
+	return summaries, nil
+}
+
+// getRepoSummaryBatch summarizes a batch of repositories in a single request.
+// If GitHub returns errors along with the summaries of some repositories, the summaries and the error messages are returned. The request only fails if no repository could be summarized
+func getRepoSummaryBatch(ctx context.Context, client Client, repos [][2]string) (RepoSummaries, []string, error) {
+	variables := map[string]interface{}{}
+	for i, v := range repos {
+		variables[fmt.Sprintf("owner%d", i)] = githubv4.String(v[0])
+		variables[fmt.Sprintf("name%d", i)] = githubv4.String(v[1])
+	}
+
+	q := reflect.New(repoSummaryQuery(len(repos)))
+	err := client.Query(ctx, q.Interface(), variables)
+	messages := graphQLErrorMessages(err)
+	if err != nil && messages == nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	summaries := RepoSummaries{}
+	for i := range repos {
+		if v, ok := q.Elem().Field(i).Interface().(*RepoSummary); ok && v != nil {
+			summaries = append(summaries, *v)
+		}
+	}
+
+	if err != nil && len(summaries) == 0 {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return summaries, messages, nil
+}
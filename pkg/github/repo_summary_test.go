@@ -0,0 +1,210 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetRepoSummaries(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListRepoSummaryOptions{
+			Owner:        "grafana",
+			Repositories: "grafana, loki, prometheus/prometheus",
+		}
+	)
+
+	testVariables := testutil.GetTestVariablesFunction("owner0", "name0", "owner1", "name1", "owner2", "name2")
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(reflect.New(repoSummaryQuery(3)).Interface()),
+	)
+
+	_, err := GetRepoSummaries(ctx, client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseRepositoryList(t *testing.T) {
+	repos := parseRepositoryList("grafana, ,prometheus/prometheus,loki ", "grafana")
+
+	expected := [][2]string{
+		{"grafana", "grafana"},
+		{"prometheus", "prometheus"},
+		{"grafana", "loki"},
+	}
+
+	if !reflect.DeepEqual(repos, expected) {
+		t.Fatalf("Unexpected repositories. Expected %v, received %v", expected, repos)
+	}
+}
+
+func TestRepoSummariesDataframe(t *testing.T) {
+	grafana := RepoSummary{
+		NameWithOwner:  "grafana/grafana",
+		StargazerCount: 40000,
+		ForkCount:      8000,
+	}
+	grafana.Issues.TotalCount = 2500
+	grafana.PullRequests.TotalCount = 250
+
+	loki := RepoSummary{
+		NameWithOwner:  "grafana/loki",
+		StargazerCount: 14000,
+		ForkCount:      1800,
+	}
+	loki.Issues.TotalCount = 600
+	loki.PullRequests.TotalCount = 80
+
+	if err := testutil.CheckGoldenFramer("repo_summary", RepoSummaries{grafana, loki}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetRepoSummariesPartialErrors(t *testing.T) {
+	opts := models.ListRepoSummaryOptions{
+		Owner:        "grafana",
+		Repositories: "grafana, private",
+	}
+
+	t.Run("the summaries that were returned are kept along with the errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{
+				"data": {"r0": {"nameWithOwner": "grafana/grafana", "stargazerCount": 10}, "r1": null},
+				"errors": [{"type": "NOT_FOUND", "path": ["r1"], "message": "Could not resolve to a Repository with the name 'grafana/private'."}]
+			}`))
+		}))
+		defer srv.Close()
+
+		summaries, err := GetRepoSummaries(context.Background(), githubv4.NewEnterpriseClient(srv.URL, srv.Client()), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(summaries.Summaries) != 1 || summaries.Summaries[0].NameWithOwner != "grafana/grafana" {
+			t.Fatalf("Unexpected summaries: %v", summaries.Summaries)
+		}
+
+		frames := summaries.Frames()
+		if frames[0].Meta == nil || len(frames[0].Meta.Notices) != 1 || frames[0].Meta.Notices[0].Text != "Could not resolve to a Repository with the name 'grafana/private'." {
+			t.Fatalf("Expected the error as a notice in the frame meta data, received %v", frames[0].Meta)
+		}
+	})
+
+	t.Run("the query fails if no repository could be summarized", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data": null, "errors": [{"message": "Something went wrong"}]}`))
+		}))
+		defer srv.Close()
+
+		if _, err := GetRepoSummaries(context.Background(), githubv4.NewEnterpriseClient(srv.URL, srv.Client()), opts); err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+
+	t.Run("errors without a GraphQL response fail the query", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		if _, err := GetRepoSummaries(context.Background(), githubv4.NewEnterpriseClient(srv.URL, srv.Client()), opts); err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+}
+
+// repoSummaryBatchClient summarizes every repository in a batch with its owner and name, and records the size of every batch and the number of requests that were sent at the same time
+type repoSummaryBatchClient struct {
+	mu            sync.Mutex
+	batches       []int
+	active        int
+	maxConcurrent int
+}
+
+func (c *repoSummaryBatchClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	c.mu.Lock()
+	c.active++
+	if c.active > c.maxConcurrent {
+		c.maxConcurrent = c.active
+	}
+	c.batches = append(c.batches, len(variables)/2)
+	c.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	v := reflect.ValueOf(q).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		v.Field(i).Set(reflect.ValueOf(&RepoSummary{
+			NameWithOwner: fmt.Sprintf("%s/%s", variables[fmt.Sprintf("owner%d", i)], variables[fmt.Sprintf("name%d", i)]),
+		}))
+	}
+
+	c.mu.Lock()
+	c.active--
+	c.mu.Unlock()
+	return nil
+}
+
+func TestGetRepoSummariesBatches(t *testing.T) {
+	client := &repoSummaryBatchClient{}
+	opts := models.ListRepoSummaryOptions{
+		Owner:        "grafana",
+		Repositories: "r1, r2, r3, r4, r5, r6, r7",
+		BatchSize:    2,
+		Concurrency:  2,
+	}
+
+	summaries, err := GetRepoSummaries(context.Background(), client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Ints(client.batches)
+	if !reflect.DeepEqual(client.batches, []int{1, 2, 2, 2}) {
+		t.Fatalf("Unexpected batch sizes: %v", client.batches)
+	}
+
+	if client.maxConcurrent > 2 {
+		t.Fatalf("Expected at most 2 requests at the same time, received %d", client.maxConcurrent)
+	}
+
+	names := []string{}
+	for _, v := range summaries.Summaries {
+		names = append(names, v.NameWithOwner)
+	}
+
+	expected := []string{"grafana/r1", "grafana/r2", "grafana/r3", "grafana/r4", "grafana/r5", "grafana/r6", "grafana/r7"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Unexpected summaries. Expected %v, received %v", expected, names)
+	}
+}
+
+func TestRepoSummaryLimits(t *testing.T) {
+	for _, v := range []struct {
+		opts                   models.ListRepoSummaryOptions
+		batchSize, concurrency int
+	}{
+		{models.ListRepoSummaryOptions{}, RepoSummaryBatchSize, RepoSummaryConcurrency},
+		{models.ListRepoSummaryOptions{BatchSize: 10, Concurrency: 1}, 10, 1},
+		{models.ListRepoSummaryOptions{BatchSize: 1000, Concurrency: 1000}, RepoSummaryMaxBatchSize, RepoSummaryMaxConcurrency},
+	} {
+		batchSize, concurrency := repoSummaryLimits(v.opts)
+		if batchSize != v.batchSize || concurrency != v.concurrency {
+			t.Fatalf("Unexpected limits for %v. Expected %d and %d, received %d and %d", v.opts, v.batchSize, v.concurrency, batchSize, concurrency)
+		}
+	}
+}
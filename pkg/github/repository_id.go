@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// RepositoryCacheDuration is how long the node ID, owner, name, and default branch of a repository are reused after they were looked up
+const RepositoryCacheDuration = time.Minute * 5
+
+// RepositoryInfo is what identifies a repository: its node ID, its current owner and name, and its default branch
+type RepositoryInfo struct {
+	ID    string
+	Name  string
+	Owner struct {
+		Login string
+	}
+	DefaultBranchRef *struct {
+		Name string
+	}
+}
+
+// DefaultBranch returns the name of the default branch of the repository, or an empty string if the repository is empty
+func (r RepositoryInfo) DefaultBranch() string {
+	if r.DefaultBranchRef == nil {
+		return ""
+	}
+
+	return r.DefaultBranchRef.Name
+}
+
+// The RepositoryResolver interface is satisfied by Clients that look up a repository once and reuse it for the following queries, like the Client of the Datasource.
+// Functions that need to look up a repository use it if the Client they are given implements it
+type RepositoryResolver interface {
+	Client
+	Repository(ctx context.Context, owner string, name string) (RepositoryInfo, error)
+	RepositoryByID(ctx context.Context, id string) (RepositoryInfo, error)
+}
+
+// The wrappedClient interface is satisfied by the Clients that wrap the Client of the Datasource for a single query, like the cursorClient and the debugClient
+type wrappedClient interface {
+	unwrap() Client
+}
+
+// repositoryResolver returns the RepositoryResolver that the client implements or wraps, so that the wrappers of a query do not hide the repository cache
+func repositoryResolver(client Client) (RepositoryResolver, bool) {
+	for {
+		if r, ok := client.(RepositoryResolver); ok {
+			return r, true
+		}
+
+		w, ok := client.(wrappedClient)
+		if !ok {
+			return nil, false
+		}
+		client = w.unwrap()
+	}
+}
+
+// QueryRepositoryID is the GraphQL query for the node ID of a repository
+// {
+//   repository(name: "grafana", owner: "grafana") {
+//     id
+//     defaultBranchRef {
+//       name
+//     }
+//   }
+// }
+type QueryRepositoryID struct {
+	Repository RepositoryInfo `graphql:"repository(name: $name, owner: $owner)"`
+}
+
+// QueryRepositoryByID is the GraphQL query for the current owner and name of a repository using its node ID
+// {
+//   node(id: "MDEwOlJlcG9zaXRvcnkxNTExMTgyMQ==") {
+//     ... on Repository {
+//       name
+//       owner {
+//         login
+//       }
+//     }
+//   }
+// }
+type QueryRepositoryByID struct {
+	Node struct {
+		Repository RepositoryInfo `graphql:"... on Repository"`
+	} `graphql:"node(id: $id)"`
+}
+
+// queryRepository looks up a repository by its owner and name
+func queryRepository(ctx context.Context, client Client, owner string, name string) (RepositoryInfo, error) {
+	q := &QueryRepositoryID{}
+	variables := map[string]interface{}{
+		"name":  githubv4.String(name),
+		"owner": githubv4.String(owner),
+	}
+
+	if err := client.Query(ctx, q, variables); err != nil {
+		return RepositoryInfo{}, errors.WithStack(err)
+	}
+
+	return q.Repository, nil
+}
+
+// queryRepositoryByID looks up a repository by its node ID
+func queryRepositoryByID(ctx context.Context, client Client, id string) (RepositoryInfo, error) {
+	q := &QueryRepositoryByID{}
+	variables := map[string]interface{}{
+		"id": githubv4.ID(id),
+	}
+
+	if err := client.Query(ctx, q, variables); err != nil {
+		return RepositoryInfo{}, errors.WithStack(err)
+	}
+
+	// The node is empty if it is not a repository
+	if q.Node.Repository.Name == "" {
+		return RepositoryInfo{}, errors.Wrap(dserrors.ErrorRepositoryIDNotFound, id)
+	}
+
+	return q.Node.Repository, nil
+}
+
+// getRepository looks up a repository by its owner and name, using the RepositoryResolver if the client implements or wraps one
+func getRepository(ctx context.Context, client Client, owner string, name string) (RepositoryInfo, error) {
+	if r, ok := repositoryResolver(client); ok {
+		return r.Repository(ctx, owner, name)
+	}
+
+	return queryRepository(ctx, client, owner, name)
+}
+
+// GetRepositoryID returns the node ID of a repository, which does not change when the repository is renamed or transferred
+func GetRepositoryID(ctx context.Context, client Client, owner string, repo string) (string, error) {
+	r, err := getRepository(ctx, client, owner, repo)
+	if err != nil {
+		return "", err
+	}
+
+	return r.ID, nil
+}
+
+// GetDefaultBranch returns the name of the default branch of a repository
+func GetDefaultBranch(ctx context.Context, client Client, owner string, repo string) (string, error) {
+	r, err := getRepository(ctx, client, owner, repo)
+	if err != nil {
+		return "", err
+	}
+
+	return r.DefaultBranch(), nil
+}
+
+// GetRepositoryByID returns the current owner and name of the repository with the node ID
+func GetRepositoryByID(ctx context.Context, client Client, id string) (string, string, error) {
+	var (
+		r   RepositoryInfo
+		err error
+	)
+
+	if resolver, ok := repositoryResolver(client); ok {
+		r, err = resolver.RepositoryByID(ctx, id)
+	} else {
+		r, err = queryRepositoryByID(ctx, client, id)
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return r.Owner.Login, r.Name, nil
+}
+
+// refOrDefaultBranch returns the git reference, or the default branch of the repository if it is empty
+func refOrDefaultBranch(ctx context.Context, client Client, owner string, repo string, ref string) (string, error) {
+	if ref != "" {
+		return ref, nil
+	}
+
+	return GetDefaultBranch(ctx, client, owner, repo)
+}
+
+type repositoryCacheEntry struct {
+	repository RepositoryInfo
+	expiresAt  time.Time
+}
+
+// repositoryCache is a Client that implements RepositoryResolver by keeping every repository that it looked up for RepositoryCacheDuration.
+// A repository that was looked up by its owner and name is reused when it is looked up by its node ID, and the other way around
+type repositoryCache struct {
+	Client
+
+	mu     sync.Mutex
+	byName map[string]repositoryCacheEntry
+	byID   map[string]repositoryCacheEntry
+}
+
+func newRepositoryCache(client Client) *repositoryCache {
+	return &repositoryCache{
+		Client: client,
+		byName: map[string]repositoryCacheEntry{},
+		byID:   map[string]repositoryCacheEntry{},
+	}
+}
+
+// repositoryCacheName returns the key of a repository by its owner and name. GitHub ignores the case of both
+func repositoryCacheName(owner string, name string) string {
+	return strings.ToLower(owner + "/" + name)
+}
+
+func (c *repositoryCache) get(entries map[string]repositoryCacheEntry, key string) (RepositoryInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := entries[key]
+	if !ok || entry.expiresAt.Before(time.Now()) {
+		return RepositoryInfo{}, false
+	}
+
+	return entry.repository, true
+}
+
+func (c *repositoryCache) save(r RepositoryInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for _, entries := range []map[string]repositoryCacheEntry{c.byName, c.byID} {
+		for k, v := range entries {
+			if v.expiresAt.Before(now) {
+				delete(entries, k)
+			}
+		}
+	}
+
+	entry := repositoryCacheEntry{
+		repository: r,
+		expiresAt:  now.Add(RepositoryCacheDuration),
+	}
+
+	c.byName[repositoryCacheName(r.Owner.Login, r.Name)] = entry
+	if r.ID != "" {
+		c.byID[r.ID] = entry
+	}
+}
+
+// Repository looks up a repository by its owner and name, or returns it from the cache
+func (c *repositoryCache) Repository(ctx context.Context, owner string, name string) (RepositoryInfo, error) {
+	if r, ok := c.get(c.byName, repositoryCacheName(owner, name)); ok {
+		return r, nil
+	}
+
+	r, err := queryRepository(ctx, c.Client, owner, name)
+	if err != nil {
+		return RepositoryInfo{}, err
+	}
+
+	c.save(r)
+	return r, nil
+}
+
+// RepositoryByID looks up a repository by its node ID, or returns it from the cache
+func (c *repositoryCache) RepositoryByID(ctx context.Context, id string) (RepositoryInfo, error) {
+	if r, ok := c.get(c.byID, id); ok {
+		return r, nil
+	}
+
+	r, err := queryRepositoryByID(ctx, c.Client, id)
+	if err != nil {
+		return RepositoryInfo{}, err
+	}
+
+	c.save(r)
+	return r, nil
+}
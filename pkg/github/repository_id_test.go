@@ -0,0 +1,154 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+func TestGetRepositoryID(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("name", "owner"),
+		testutil.GetTestQueryFunction(&QueryRepositoryID{}),
+	)
+
+	if _, err := GetRepositoryID(context.Background(), client, "grafana", "grafana"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type repositoryByIDClient struct {
+	name  string
+	owner string
+}
+
+func (c *repositoryByIDClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	query := q.(*QueryRepositoryByID)
+	query.Node.Repository.Name = c.name
+	query.Node.Repository.Owner.Login = c.owner
+	return nil
+}
+
+func TestGetRepositoryByID(t *testing.T) {
+	t.Run("the current owner and name of the repository should be returned", func(t *testing.T) {
+		owner, repo, err := GetRepositoryByID(context.Background(), &repositoryByIDClient{name: "new-name", owner: "grafana"}, "R_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if owner != "grafana" || repo != "new-name" {
+			t.Fatalf("Unexpected repository. Expected 'grafana/new-name', received '%s/%s'", owner, repo)
+		}
+	})
+
+	t.Run("node IDs that are not repositories should return an error", func(t *testing.T) {
+		_, _, err := GetRepositoryByID(context.Background(), &repositoryByIDClient{}, "I_1")
+		if !errors.Is(err, dserrors.ErrorRepositoryIDNotFound) {
+			t.Fatalf("Expected error '%s', received '%v'", dserrors.ErrorRepositoryIDNotFound, err)
+		}
+	})
+}
+
+type repositoryClient struct {
+	queries int
+}
+
+func (c *repositoryClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	c.queries++
+
+	var r *RepositoryInfo
+	switch query := q.(type) {
+	case *QueryRepositoryID:
+		r = &query.Repository
+	case *QueryRepositoryByID:
+		r = &query.Node.Repository
+	}
+
+	r.ID = "R_1"
+	r.Name = "grafana"
+	r.Owner.Login = "grafana"
+	r.DefaultBranchRef = &struct {
+		Name string
+	}{Name: "main"}
+
+	return nil
+}
+
+func TestRepositoryCache(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		client = &repositoryClient{}
+		cache  = newRepositoryCache(client)
+	)
+
+	id, err := GetRepositoryID(ctx, cache, "grafana", "grafana")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	branch, err := GetDefaultBranch(ctx, cache, "Grafana", "Grafana")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner, repo, err := GetRepositoryByID(ctx, cache, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id != "R_1" || branch != "main" || owner != "grafana" || repo != "grafana" {
+		t.Fatalf("Unexpected repository '%s' (%s/%s, default branch '%s')", id, owner, repo, branch)
+	}
+
+	if client.queries != 1 {
+		t.Fatalf("Expected the repository to be looked up once, received %d queries", client.queries)
+	}
+}
+
+func TestRepositoryCacheWrappedClient(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		client = &repositoryClient{}
+		cache  = newRepositoryCache(client)
+	)
+
+	if _, err := GetRepositoryID(ctx, cache, "grafana", "grafana"); err != nil {
+		t.Fatal(err)
+	}
+
+	debug, _ := newDebugClient(cache, true)
+	for _, wrapped := range []Client{
+		newCursorClient(cache, ""),
+		debug,
+		&issueRangeSplitter{client: newCursorClient(debug, "")},
+	} {
+		if _, err := GetRepositoryID(ctx, wrapped, "grafana", "grafana"); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err := GetRepositoryByID(ctx, wrapped, "R_1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if client.queries != 1 {
+		t.Fatalf("Expected the wrapped clients to use the cached repository, received %d queries", client.queries)
+	}
+}
+
+func TestRefOrDefaultBranch(t *testing.T) {
+	client := &repositoryClient{}
+
+	ref, err := refOrDefaultBranch(context.Background(), client, "grafana", "grafana", "release-7.0")
+	if err != nil || ref != "release-7.0" || client.queries != 0 {
+		t.Fatalf("Expected the ref to be used without looking up the repository, received '%s' after %d queries (%v)", ref, client.queries, err)
+	}
+
+	ref, err = refOrDefaultBranch(context.Background(), client, "grafana", "grafana", "")
+	if err != nil || ref != "main" {
+		t.Fatalf("Expected the default branch, received '%s' (%v)", ref, err)
+	}
+}
@@ -2,8 +2,10 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
 	"github.com/grafana/github-datasource/pkg/httputil"
 	"github.com/grafana/github-datasource/pkg/models"
 )
@@ -61,3 +63,71 @@ func (d *Datasource) HandleGetMilestones(w http.ResponseWriter, r *http.Request)
 
 	httputil.WriteResponse(w, milestones)
 }
+
+// ViewerResponse is the response of the resource call for getting the authenticated user
+type ViewerResponse struct {
+	Login string `json:"login"`
+}
+
+// HandleGetViewer is the HTTP handler for the resource call for getting the login of the authenticated user, which can be used in template variables
+func (d *Datasource) HandleGetViewer(w http.ResponseWriter, r *http.Request) {
+	login, err := GetViewer(r.Context(), d.client)
+	if err != nil {
+		httputil.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	httputil.WriteResponse(w, ViewerResponse{Login: login})
+}
+
+// RepositoryIDResponse is the response of the resource call for getting the node ID of a repository
+type RepositoryIDResponse struct {
+	ID string `json:"id"`
+}
+
+// HandleGetRepositoryID is the HTTP handler for the resource call for getting the node ID of a repository, so that it can be stored in a query instead of the owner and name
+func (d *Datasource) HandleGetRepositoryID(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	id, err := GetRepositoryID(r.Context(), d.client, q.Get("owner"), q.Get("repository"))
+	if err != nil {
+		httputil.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	httputil.WriteResponse(w, RepositoryIDResponse{ID: id})
+}
+
+// WorkflowDispatchResponse is the response of the resource call for triggering a workflow run
+type WorkflowDispatchResponse struct {
+	Dispatched bool `json:"dispatched"`
+}
+
+func handleWorkflowDispatch(ctx context.Context, client RESTClient, r *http.Request) (WorkflowDispatchResponse, error) {
+	opts := models.WorkflowDispatchOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		return WorkflowDispatchResponse{}, err
+	}
+
+	if err := DispatchWorkflow(ctx, client, opts); err != nil {
+		return WorkflowDispatchResponse{}, err
+	}
+
+	return WorkflowDispatchResponse{Dispatched: true}, nil
+}
+
+// HandleWorkflowDispatch is the HTTP handler for the resource call for triggering a GitHub Actions workflow run.
+// It is only allowed if triggering workflows is enabled in the datasource settings.
+func (d *Datasource) HandleWorkflowDispatch(w http.ResponseWriter, r *http.Request) {
+	if !d.workflowDispatchEnabled {
+		httputil.WriteError(w, http.StatusForbidden, dserrors.ErrorWorkflowDispatchDisabled)
+		return
+	}
+
+	res, err := handleWorkflowDispatch(r.Context(), d.restClient, r)
+	if err != nil {
+		httputil.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	httputil.WriteResponse(w, res)
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RESTError is returned by the REST client when GitHub responds with an unsuccessful status code
+type RESTError struct {
+	StatusCode       int
+	Message          string `json:"message"`
+	DocumentationURL string `json:"documentation_url"`
+}
+
+func (e *RESTError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("github responded with status code %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("github responded with status code %d: %s", e.StatusCode, e.Message)
+}
+
+// RESTUser is the simplified user object that is embedded in REST API responses
+type RESTUser struct {
+	Login string `json:"login"`
+}
+
+// restClient satisfies the RESTClient interface and sends requests to the GitHub REST (v3) API
+type restClient struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// newRESTClient creates a REST client for github.com, or for a GitHub Enterprise server if the githubURL is not empty
+func newRESTClient(httpClient *http.Client, githubURL string) *restClient {
+	baseURL := "https://api.github.com"
+	if githubURL != "" {
+		baseURL = fmt.Sprintf("%s/api/v3", strings.TrimSuffix(githubURL, "/"))
+	}
+
+	return &restClient{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+}
+
+// Get sends a GET request to the path with the URL parameters and decodes the JSON response into v
+func (c *restClient) Get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	u := c.baseURL + path
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, v)
+}
+
+// Post sends a POST request to the path with the body encoded as JSON. If v is not nil, the JSON response is decoded into it
+func (c *restClient) Post(ctx context.Context, path string, body interface{}, v interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.do(req, v)
+}
+
+// do sends the request and decodes the JSON response into v. Unsuccessful status codes are returned as a RESTError
+func (c *restClient) do(req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		restErr := &RESTError{
+			StatusCode: res.StatusCode,
+		}
+		// The error message is not always JSON, in which case only the status code is returned
+		_ = json.NewDecoder(res.Body).Decode(restErr)
+		return restErr
+	}
+
+	// Some endpoints, like workflow dispatches, respond with "204 No Content"
+	if v == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
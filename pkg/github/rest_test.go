@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestRESTClientGet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/grafana/grafana":
+			if r.URL.Query().Get("page") != "2" {
+				t.Errorf("Unexpected page parameter. Expected '2', received '%s'", r.URL.Query().Get("page"))
+			}
+			_, _ = w.Write([]byte(`{"name": "grafana"}`))
+		case "/api/v3/repos/grafana/private":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newRESTClient(srv.Client(), srv.URL+"/")
+
+	t.Run("successful responses are decoded", func(t *testing.T) {
+		v := struct {
+			Name string `json:"name"`
+		}{}
+		if err := client.Get(context.Background(), "/repos/grafana/grafana", url.Values{"page": []string{"2"}}, &v); err != nil {
+			t.Fatal(err)
+		}
+
+		if v.Name != "grafana" {
+			t.Fatalf("Unexpected name. Expected 'grafana', received '%s'", v.Name)
+		}
+	})
+
+	t.Run("unsuccessful responses return a RESTError with the GitHub message", func(t *testing.T) {
+		err := client.Get(context.Background(), "/repos/grafana/private", nil, &struct{}{})
+
+		var restErr *RESTError
+		if !errors.As(err, &restErr) {
+			t.Fatalf("Expected a RESTError, received '%v'", err)
+		}
+
+		if restErr.StatusCode != http.StatusForbidden || restErr.Message != "Resource not accessible by integration" {
+			t.Fatalf("Unexpected RESTError: %v", restErr)
+		}
+	})
+
+	t.Run("unsuccessful responses without a message return a RESTError", func(t *testing.T) {
+		err := client.Get(context.Background(), "/not-found", nil, &struct{}{})
+
+		var restErr *RESTError
+		if !errors.As(err, &restErr) || restErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("Expected a 404 RESTError, received '%v'", err)
+		}
+	})
+}
+
+func TestRESTClientPost(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Unexpected method. Expected 'POST', received '%s'", r.Method)
+		}
+
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+
+		if body["ref"] != "main" {
+			t.Errorf("Unexpected ref in the body. Expected 'main', received '%s'", body["ref"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := newRESTClient(srv.Client(), srv.URL)
+
+	if err := client.Post(context.Background(), "/repos/grafana/grafana/actions/workflows/deploy.yml/dispatches", map[string]string{"ref": "main"}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
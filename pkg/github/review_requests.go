@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// ReviewRequestedPullRequest is the part of an open pull request that is needed to list the requested reviews
+type ReviewRequestedPullRequest struct {
+	Number     int64
+	Title      string
+	URL        string
+	Author     Author
+	Repository Repository
+	CreatedAt  githubv4.DateTime
+}
+
+// ReviewRequest is an open pull request that is waiting for the review of a user
+type ReviewRequest struct {
+	ReviewRequestedPullRequest
+
+	// AgeSeconds is the number of seconds since the pull request was opened
+	AgeSeconds float64
+}
+
+// ReviewRequests is a list of pull requests waiting for a review, sorted by the time they were opened (oldest first)
+type ReviewRequests []ReviewRequest
+
+// Frames converts the list of review requests to a Grafana DataFrame
+func (r ReviewRequests) Frames() data.Frames {
+	age := data.NewField("age_seconds", nil, []float64{})
+	age.Config = &data.FieldConfig{
+		Unit: "s", // The values are in seconds
+	}
+
+	frame := data.NewFrame(
+		"review_requests",
+		data.NewField("number", nil, []int64{}),
+		data.NewField("title", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("author_login", nil, []string{}),
+		data.NewField("repository", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		age,
+	)
+
+	for _, v := range r {
+		frame.AppendRow(
+			v.Number,
+			v.Title,
+			v.URL,
+			v.Author.Login,
+			v.Repository.NameWithOwner,
+			v.CreatedAt.Time,
+			v.AgeSeconds,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// QuerySearchReviewRequests is the GraphQL query for the open pull requests that are waiting for the review of a user
+// {
+//   search(query: "is:pr is:open review-requested:@me repo:grafana/grafana sort:created-asc", type: ISSUE, first: 100) {
+//     nodes {
+//       ... on PullRequest {
+//         number
+//         title
+//         createdAt
+//       }
+//     }
+//   }
+// }
+type QuerySearchReviewRequests struct {
+	Search struct {
+		Nodes []struct {
+			PullRequest ReviewRequestedPullRequest `graphql:"... on PullRequest"`
+		}
+		PageInfo PageInfo
+	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
+}
+
+// reviewRequestsQuery builds the search for the open pull requests that are waiting for the review of the login, or the authenticated user if the login is empty
+func reviewRequestsQuery(opts models.ListReviewRequestsOptions) string {
+	login := opts.Login
+	if login == "" {
+		login = "@me"
+	}
+
+	search := []string{
+		"is:pr",
+		"is:open",
+		fmt.Sprintf("review-requested:%s", login),
+	}
+
+	if opts.Repository != "" {
+		search = append(search, fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository))
+	} else if opts.Owner != "" {
+		search = append(search, fmt.Sprintf("org:%s", opts.Owner))
+	}
+
+	if opts.Query != nil {
+		search = append(search, *opts.Query)
+	}
+
+	return strings.Join(append(search, "sort:created-asc"), " ")
+}
+
+// GetReviewRequests lists the open pull requests that are waiting for the review of a user, along with their age at `now`
+func GetReviewRequests(ctx context.Context, client Client, opts models.ListReviewRequestsOptions, now time.Time) (ReviewRequests, error) {
+	var (
+		variables = map[string]interface{}{
+			"cursor": (*githubv4.String)(nil),
+			"query":  githubv4.String(reviewRequestsQuery(opts)),
+		}
+
+		requests = ReviewRequests{}
+	)
+
+	for {
+		q := &QuerySearchReviewRequests{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Search.Nodes {
+			requests = append(requests, ReviewRequest{
+				ReviewRequestedPullRequest: v.PullRequest,
+				AgeSeconds:                 now.UTC().Sub(v.PullRequest.CreatedAt.UTC()).Seconds(),
+			})
+		}
+
+		if !q.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	return requests, nil
+}
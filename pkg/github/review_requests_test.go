@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetReviewRequests(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		testutil.GetTestVariablesFunction("query", "cursor"),
+		testutil.GetTestQueryFunction(&QuerySearchReviewRequests{}),
+	)
+
+	_, err := GetReviewRequests(context.Background(), client, models.ListReviewRequestsOptions{Owner: "grafana", Repository: "grafana"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReviewRequestsQuery(t *testing.T) {
+	t.Run("the authenticated user should be used if no login is set", func(t *testing.T) {
+		var (
+			result = reviewRequestsQuery(models.ListReviewRequestsOptions{Owner: "grafana", Repository: "grafana"})
+			expect = "is:pr is:open review-requested:@me repo:grafana/grafana sort:created-asc"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from reviewRequestsQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+
+	t.Run("a login without an owner should search every repository", func(t *testing.T) {
+		var (
+			result = reviewRequestsQuery(models.ListReviewRequestsOptions{Login: "octocat"})
+			expect = "is:pr is:open review-requested:octocat sort:created-asc"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from reviewRequestsQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+}
+
+func TestReviewRequestsDataframe(t *testing.T) {
+	now, err := time.Parse(time.RFC3339, "2020-08-27T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr := ReviewRequestedPullRequest{
+		Number:     12,
+		Title:      "Add review requests query",
+		URL:        "https://github.com/grafana/github-datasource/pull/12",
+		Author:     Author{Login: "testUser"},
+		Repository: Repository{NameWithOwner: "grafana/github-datasource"},
+		CreatedAt:  githubv4.DateTime{Time: now.Add(-36 * time.Hour)},
+	}
+
+	requests := ReviewRequests{
+		{
+			ReviewRequestedPullRequest: pr,
+			AgeSeconds:                 now.Sub(pr.CreatedAt.Time).Seconds(),
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("review_requests", requests); err != nil {
+		t.Fatal(err)
+	}
+}
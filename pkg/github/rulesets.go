@@ -0,0 +1,152 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// Ruleset is a set of rules (like required reviews) that applies to branches or tags of a repository, either defined in the repository or inherited from its organization
+type Ruleset struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Target      string `json:"target"`
+	Enforcement string `json:"enforcement"`
+	SourceType  string `json:"source_type"`
+	Source      string `json:"source"`
+
+	// BypassActors is the number of users, teams, and apps that may bypass the ruleset. It is retrieved with a separate request for every ruleset, because the list of rulesets does not include them.
+	// It is nil if the access token is not allowed to read the ruleset
+	BypassActors *int64 `json:"-"`
+}
+
+// Rulesets is a list of GitHub rulesets
+type Rulesets []Ruleset
+
+// Frames converts the list of rulesets to a Grafana DataFrame.
+// A notice is added if the bypass actors of some rulesets could not be read
+func (r Rulesets) Frames() data.Frames {
+	frame := data.NewFrame(
+		"rulesets",
+		data.NewField("id", nil, []int64{}),
+		data.NewField("name", nil, []string{}),
+		data.NewField("target", nil, []string{}),
+		data.NewField("enforcement", nil, []string{}),
+		data.NewField("source_type", nil, []string{}),
+		data.NewField("source", nil, []string{}),
+		data.NewField("bypass_actors", nil, []*int64{}),
+	)
+
+	forbidden := 0
+	for _, v := range r {
+		if v.BypassActors == nil {
+			forbidden++
+		}
+
+		frame.AppendRow(
+			v.ID,
+			v.Name,
+			v.Target,
+			v.Enforcement,
+			v.SourceType,
+			v.Source,
+			v.BypassActors,
+		)
+	}
+
+	if forbidden > 0 {
+		frame.Meta = &data.FrameMeta{
+			Notices: []data.Notice{
+				{
+					Severity: data.NoticeSeverityWarning,
+					Text:     fmt.Sprintf("The access token is not allowed to read the bypass actors of %d of the %d rulesets", forbidden, len(r)),
+				},
+			},
+		}
+	}
+
+	return data.Frames{frame}
+}
+
+// rulesetsPath returns the REST path of the rulesets of a repository (including the ones inherited from its organization), or the organization if no repository is set
+func rulesetsPath(opts models.ListRulesetsOptions) string {
+	if opts.Repository == "" {
+		return fmt.Sprintf("/orgs/%s/rulesets", opts.Owner)
+	}
+
+	return fmt.Sprintf("/repos/%s/%s/rulesets", opts.Owner, opts.Repository)
+}
+
+// GetAllRulesets lists the rulesets of a repository or organization using the REST API: /repos/{owner}/{repo}/rulesets or /orgs/{org}/rulesets
+// The number of bypass actors of every ruleset is retrieved with an additional request per ruleset. It is left empty if GitHub responds with a 403 for a ruleset.
+// GitHub responds with an empty list if there are no rulesets, so a 404 is returned as ErrorRulesetsNotFound.
+func GetAllRulesets(ctx context.Context, client RESTClient, opts models.ListRulesetsOptions) (Rulesets, error) {
+	var (
+		path   = rulesetsPath(opts)
+		params = url.Values{
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+
+		rulesets = Rulesets{}
+	)
+
+	if opts.Repository != "" {
+		params.Set("includes_parents", "true")
+	}
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		r := Rulesets{}
+		if err := client.Get(ctx, path, params, &r); err != nil {
+			return nil, rulesetsError(err, opts)
+		}
+
+		rulesets = append(rulesets, r...)
+
+		if len(r) < RESTPageSize {
+			break
+		}
+	}
+
+	for i, v := range rulesets {
+		ruleset := struct {
+			BypassActors []struct {
+				ActorType string `json:"actor_type"`
+			} `json:"bypass_actors"`
+		}{}
+		if err := client.Get(ctx, fmt.Sprintf("%s/%d", path, v.ID), nil, &ruleset); err != nil {
+			// The ruleset is still listed if the access token can not read its details, like a ruleset inherited from an organization that it is not an admin of
+			var restErr *RESTError
+			if errors.As(err, &restErr) && restErr.StatusCode == http.StatusForbidden {
+				continue
+			}
+			return nil, errors.WithStack(err)
+		}
+
+		count := int64(len(ruleset.BypassActors))
+		rulesets[i].BypassActors = &count
+	}
+
+	return rulesets, nil
+}
+
+// rulesetsError replaces the 404 that GitHub returns when the repository or organization can not be found with a more helpful error
+func rulesetsError(err error, opts models.ListRulesetsOptions) error {
+	var restErr *RESTError
+	if errors.As(err, &restErr) && restErr.StatusCode == http.StatusNotFound {
+		if opts.Repository == "" {
+			return errors.Wrap(dserrors.ErrorRulesetsNotFound, opts.Owner)
+		}
+		return errors.Wrapf(dserrors.ErrorRulesetsNotFound, "%s/%s", opts.Owner, opts.Repository)
+	}
+
+	return errors.WithStack(err)
+}
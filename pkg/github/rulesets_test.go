@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+func TestGetAllRulesets(t *testing.T) {
+	t.Run("repository rulesets should include the rulesets of the organization", func(t *testing.T) {
+		client := testutil.NewTestRESTClient(t,
+			testutil.GetTestRequestFunction("/repos/grafana/grafana/rulesets", "includes_parents", "per_page", "page"),
+		)
+
+		_, err := GetAllRulesets(context.Background(), client, models.ListRulesetsOptions{Owner: "grafana", Repository: "grafana"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("organization rulesets should be listed if no repository is set", func(t *testing.T) {
+		client := testutil.NewTestRESTClient(t,
+			testutil.GetTestRequestFunction("/orgs/grafana/rulesets", "per_page", "page"),
+		)
+
+		_, err := GetAllRulesets(context.Background(), client, models.ListRulesetsOptions{Owner: "grafana"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGetAllRulesetsBypassActors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/grafana/grafana/rulesets":
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "main", "target": "branch", "enforcement": "active", "source_type": "Repository", "source": "grafana/grafana"}, {"id": 2, "name": "org", "target": "branch", "enforcement": "active", "source_type": "Organization", "source": "grafana"}]`))
+		case "/api/v3/repos/grafana/grafana/rulesets/1":
+			_, _ = w.Write([]byte(`{"id": 1, "bypass_actors": [{"actor_type": "Team"}, {"actor_type": "Integration"}]}`))
+		case "/api/v3/repos/grafana/grafana/rulesets/2":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Must have admin rights to Repository."}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	rulesets, err := GetAllRulesets(context.Background(), newRESTClient(srv.Client(), srv.URL), models.ListRulesetsOptions{Owner: "grafana", Repository: "grafana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rulesets) != 2 || rulesets[0].BypassActors == nil || *rulesets[0].BypassActors != 2 {
+		t.Fatalf("Expected the first ruleset to have 2 bypass actors, received %+v", rulesets)
+	}
+
+	// The bypass actors of a ruleset that the access token is not allowed to read are unknown
+	if rulesets[1].BypassActors != nil {
+		t.Fatalf("Expected the bypass actors of the second ruleset to be empty, received %d", *rulesets[1].BypassActors)
+	}
+
+	meta := rulesets.Frames()[0].Meta
+	if meta == nil || len(meta.Notices) != 1 {
+		t.Fatalf("Expected a notice about the bypass actors that could not be read, received %v", meta)
+	}
+}
+
+func TestGetAllRulesetsNotFound(t *testing.T) {
+	client := &errorRESTClient{
+		err: &RESTError{StatusCode: http.StatusNotFound, Message: "Not Found"},
+	}
+
+	_, err := GetAllRulesets(context.Background(), client, models.ListRulesetsOptions{Owner: "grafan", Repository: "grafana"})
+	if !errors.Is(err, dserrors.ErrorRulesetsNotFound) {
+		t.Fatalf("Expected error '%s', received '%v'", dserrors.ErrorRulesetsNotFound, err)
+	}
+}
+
+func TestRulesetsDataframe(t *testing.T) {
+	bypassActors := int64(2)
+	rulesets := Rulesets{
+		{
+			ID:           1,
+			Name:         "Protect main",
+			Target:       "branch",
+			Enforcement:  "active",
+			SourceType:   "Repository",
+			Source:       "grafana/grafana",
+			BypassActors: &bypassActors,
+		},
+		{
+			ID:          2,
+			Name:        "Release tags",
+			Target:      "tag",
+			Enforcement: "evaluate",
+			SourceType:  "Organization",
+			Source:      "grafana",
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("rulesets", rulesets); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,108 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/pkg/errors"
+)
+
+// queryTypeScopes are the scopes of a classic personal access token that every query type needs, based on the GitHub API endpoints that it uses.
+// The queries that read repositories only need the repo scope for private repositories.
+// The GraphQL query type is not listed because the scopes depend on the query that is sent, and GitHub's error already names them. The Rate_Limit query type does not need any scope
+var queryTypeScopes = map[string]string{
+	models.QueryTypeCommits:              "repo",
+	models.QueryTypeCommitComments:       "repo",
+	models.QueryTypeCommitAuthors:        "repo",
+	models.QueryTypeIssues:               "repo",
+	models.QueryTypePinnedIssues:         "repo",
+	models.QueryTypeStaleIssues:          "repo",
+	models.QueryTypeIssueBurndown:        "repo",
+	models.QueryTypeIssueAssignees:       "repo",
+	models.QueryTypeIssueFirstResponse:   "repo",
+	models.QueryTypeMeanTimeToRestore:    "repo",
+	models.QueryTypeLeadTimeForChanges:   "repo",
+	models.QueryTypeContributors:         "repo",
+	models.QueryTypeTopContributors:      "repo",
+	models.QueryTypeContributionCalendar: "read:user",
+	models.QueryTypeTags:                 "repo",
+	models.QueryTypeReleases:             "repo",
+	models.QueryTypePullRequests:         "repo",
+	models.QueryTypePullRequestFiles:     "repo",
+	models.QueryTypeReviewRequests:       "repo",
+	models.QueryTypeDiscussions:          "repo",
+	models.QueryTypeActivity:             "repo",
+	models.QueryTypeLabels:               "repo",
+	models.QueryTypeRepositories:         "repo",
+	models.QueryTypeRepoSummary:          "repo",
+	models.QueryTypeAuditLog:             "read:audit_log",
+	models.QueryTypeOrganizations:        "read:org",
+	models.QueryTypePackages:             "read:packages",
+	models.QueryTypeMilestones:           "repo",
+	models.QueryTypeProjects:             "read:project",
+	models.QueryTypeProjectItems:         "read:project",
+	models.QueryTypeProjectIssues:        "read:project",
+	models.QueryTypeProjectTimeInStatus:  "read:project",
+	models.QueryTypeSecretScanningAlerts: "security_events",
+	models.QueryTypeDeployKeys:           "repo",
+	models.QueryTypeWorkflows:            "repo",
+	models.QueryTypeWebhooks:             "read:repo_hook (or admin:org_hook for the webhooks of an organization)",
+	models.QueryTypeRulesets:             "repo (or admin:org for the rulesets of an organization)",
+	models.QueryTypeDeployments:          "repo",
+	models.QueryTypeDeploymentFrequency:  "repo",
+	models.QueryTypeChangeFailureRate:    "repo",
+	models.QueryTypeDeploymentStatuses:   "repo",
+}
+
+// isScopeError returns true if GitHub refused the request because the access token is missing a scope or permission.
+// The REST API responds with a 403 that is not a rate limit, and the GraphQL API responds with an error that names the required scopes
+func isScopeError(err error) bool {
+	var restErr *RESTError
+	if errors.As(err, &restErr) {
+		return restErr.StatusCode == http.StatusForbidden && !strings.Contains(strings.ToLower(restErr.Message), "rate limit")
+	}
+
+	message := err.Error()
+	return strings.Contains(message, "required scopes") || strings.Contains(message, "Resource not accessible by")
+}
+
+// missingScopeError is the error that GitHub returned for a query whose access token is missing a scope, along with the scope that the query type needs.
+// The message starts with the scope, so that it is the first thing shown in the panel, and it matches dserrors.ErrorMissingScope with errors.Is
+type missingScopeError struct {
+	queryType string
+	scope     string
+	err       error
+}
+
+func (e *missingScopeError) Error() string {
+	return fmt.Sprintf("the %s query needs an access token with the %s scope. %s: %s", strings.ReplaceAll(e.queryType, "_", " "), e.scope, dserrors.ErrorMissingScope, e.err)
+}
+
+// Is returns true for dserrors.ErrorMissingScope
+func (e *missingScopeError) Is(target error) bool {
+	return target == dserrors.ErrorMissingScope
+}
+
+// Unwrap returns the error that GitHub returned
+func (e *missingScopeError) Unwrap() error {
+	return e.err
+}
+
+// ScopeError adds the scope that the query type needs to the error that GitHub returns when the access token is missing a scope.
+// GitHub's message is kept, because it can name a more specific scope, or the permission that a fine-grained token or a GitHub App is missing.
+// Other errors, including the ones that already explain what a query needs (like ErrorAuditLogUnavailable), are returned unchanged
+func ScopeError(queryType string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	scope, ok := queryTypeScopes[queryType]
+	if !ok || !isScopeError(err) {
+		return err
+	}
+
+	return &missingScopeError{queryType: queryType, scope: scope, err: err}
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+)
+
+func TestScopeError(t *testing.T) {
+	graphqlErr := errors.New("Your token has not been granted the required scopes to execute this query. The 'items' field requires one of the following scopes: ['read:project'], but your token has only been granted the: ['repo'] scopes.")
+
+	for _, tc := range []struct {
+		name      string
+		queryType string
+		err       error
+		scope     string
+	}{
+		{name: "forbidden REST response", queryType: models.QueryTypeWorkflows, err: &RESTError{StatusCode: http.StatusForbidden, Message: "Resource not accessible by personal access token"}, scope: "repo"},
+		{name: "GraphQL scope error", queryType: models.QueryTypeProjectItems, err: graphqlErr, scope: "read:project"},
+		{name: "rate limit", queryType: models.QueryTypeWorkflows, err: &RESTError{StatusCode: http.StatusForbidden, Message: "API rate limit exceeded for user ID 1."}},
+		{name: "not found", queryType: models.QueryTypeWorkflows, err: &RESTError{StatusCode: http.StatusNotFound, Message: "Not Found"}},
+		{name: "explained error", queryType: models.QueryTypeAuditLog, err: dserrors.ErrorAuditLogUnavailable},
+		{name: "organizations", queryType: models.QueryTypeOrganizations, err: &RESTError{StatusCode: http.StatusForbidden, Message: "Must have admin rights to Organization."}, scope: "read:org"},
+		{name: "ad-hoc GraphQL query", queryType: models.QueryTypeGraphQL, err: graphqlErr},
+		{name: "query type without scope", queryType: models.QueryTypeRateLimit, err: graphqlErr},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ScopeError(tc.queryType, tc.err)
+
+			if tc.scope == "" {
+				if err != tc.err {
+					t.Fatalf("Expected the error to be unchanged, received '%v'", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, dserrors.ErrorMissingScope) || !strings.Contains(err.Error(), "with the "+tc.scope+" scope") {
+				t.Fatalf("Expected an error naming the %s scope, received '%v'", tc.scope, err)
+			}
+
+			if !strings.HasPrefix(err.Error(), "the "+strings.ReplaceAll(tc.queryType, "_", " ")+" query needs an access token with the "+tc.scope+" scope") {
+				t.Fatalf("Expected the error to start with the %s scope, received '%v'", tc.scope, err)
+			}
+
+			if !strings.HasSuffix(err.Error(), tc.err.Error()) || !errors.Is(err, tc.err) {
+				t.Fatalf("Expected the error to keep GitHub's message '%s', received '%v'", tc.err, err)
+			}
+		})
+	}
+
+	if err := ScopeError(models.QueryTypeIssues, nil); err != nil {
+		t.Fatalf("Expected no error, received '%v'", err)
+	}
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// SecretScanningAlert is a secret (like an access token) that GitHub found in a repository
+type SecretScanningAlert struct {
+	Number                int64      `json:"number"`
+	State                 string     `json:"state"`
+	Resolution            string     `json:"resolution"`
+	SecretType            string     `json:"secret_type"`
+	SecretTypeDisplayName string     `json:"secret_type_display_name"`
+	HTMLURL               string     `json:"html_url"`
+	CreatedAt             time.Time  `json:"created_at"`
+	ResolvedAt            *time.Time `json:"resolved_at"`
+	ResolvedBy            *RESTUser  `json:"resolved_by"`
+
+	// Locations is the number of places in the repository where the secret was found. It is only retrieved, with a separate request for every alert, if the IncludeLocations option is set
+	Locations int64 `json:"-"`
+}
+
+// SecretScanningAlerts is a list of GitHub secret scanning alerts
+type SecretScanningAlerts []SecretScanningAlert
+
+// Frames converts the list of secret scanning alerts to a Grafana DataFrame
+func (a SecretScanningAlerts) Frames() data.Frames {
+	return SecretScanningAlertsWrapper{Alerts: a}.Frames()
+}
+
+// SecretScanningAlertsWrapper is a list of GitHub secret scanning alerts along with the query options that change how they are converted to a data frame
+type SecretScanningAlertsWrapper struct {
+	Alerts  SecretScanningAlerts
+	Options models.ListSecretScanningAlertsOptions
+}
+
+// Frames converts the list of secret scanning alerts to a Grafana DataFrame. The `locations` column is only added if the IncludeLocations option is set
+func (w SecretScanningAlertsWrapper) Frames() data.Frames {
+	timeToResolve := data.NewField("time_to_resolve", nil, []*float64{})
+	timeToResolve.Config = &data.FieldConfig{
+		Unit: "s", // The values are in seconds
+	}
+
+	frame := data.NewFrame(
+		"secret_scanning_alerts",
+		data.NewField("number", nil, []int64{}),
+		data.NewField("secret_type", nil, []string{}),
+		data.NewField("secret_type_display_name", nil, []string{}),
+		data.NewField("state", nil, []string{}),
+		data.NewField("resolution", nil, []string{}),
+		data.NewField("resolved_by", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("resolved_at", nil, []*time.Time{}),
+		timeToResolve,
+	)
+
+	if w.Options.IncludeLocations {
+		frame.Fields = append(frame.Fields, data.NewField("locations", nil, []int64{}))
+	}
+
+	for _, v := range w.Alerts {
+		var (
+			resolvedBy       string
+			resolvedAt       *time.Time
+			secondsToResolve *float64
+		)
+
+		if v.ResolvedBy != nil {
+			resolvedBy = v.ResolvedBy.Login
+		}
+
+		if v.ResolvedAt != nil && !v.ResolvedAt.IsZero() {
+			t := *v.ResolvedAt
+			resolvedAt = &t
+			s := t.UTC().Sub(v.CreatedAt.UTC()).Seconds()
+			secondsToResolve = &s
+		}
+
+		values := []interface{}{
+			v.Number,
+			v.SecretType,
+			v.SecretTypeDisplayName,
+			v.State,
+			v.Resolution,
+			resolvedBy,
+			v.HTMLURL,
+			v.CreatedAt,
+			resolvedAt,
+			secondsToResolve,
+		}
+
+		if w.Options.IncludeLocations {
+			values = append(values, v.Locations)
+		}
+
+		frame.AppendRow(values...)
+	}
+
+	return data.Frames{frame}
+}
+
+// GetAllSecretScanningAlerts lists the secret scanning alerts in a repository using the REST API: /repos/{owner}/{repo}/secret-scanning/alerts
+// If the IncludeLocations option is set, the number of locations of every alert is retrieved with an additional request per alert.
+func GetAllSecretScanningAlerts(ctx context.Context, client RESTClient, opts models.ListSecretScanningAlertsOptions) (SecretScanningAlerts, error) {
+	var (
+		path   = fmt.Sprintf("/repos/%s/%s/secret-scanning/alerts", opts.Owner, opts.Repository)
+		params = url.Values{
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+
+		alerts = SecretScanningAlerts{}
+	)
+
+	if opts.State != "" {
+		params.Set("state", opts.State)
+	}
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		a := SecretScanningAlerts{}
+		if err := client.Get(ctx, path, params, &a); err != nil {
+			return nil, secretScanningError(err, opts)
+		}
+
+		alerts = append(alerts, a...)
+
+		if len(a) < RESTPageSize {
+			break
+		}
+	}
+
+	if !opts.IncludeLocations {
+		return alerts, nil
+	}
+
+	for i, v := range alerts {
+		locations, err := countSecretScanningAlertLocations(ctx, client, path, v.Number)
+		if err != nil {
+			return nil, secretScanningError(err, opts)
+		}
+		alerts[i].Locations = locations
+	}
+
+	return alerts, nil
+}
+
+// countSecretScanningAlertLocations counts the places where the secret of an alert was found: /repos/{owner}/{repo}/secret-scanning/alerts/{number}/locations
+func countSecretScanningAlertLocations(ctx context.Context, client RESTClient, alertsPath string, number int64) (int64, error) {
+	var (
+		path   = fmt.Sprintf("%s/%d/locations", alertsPath, number)
+		params = url.Values{
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+		count int64
+	)
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		locations := []struct {
+			Type string `json:"type"`
+		}{}
+		if err := client.Get(ctx, path, params, &locations); err != nil {
+			return 0, err
+		}
+
+		count += int64(len(locations))
+
+		if len(locations) < RESTPageSize {
+			break
+		}
+	}
+
+	return count, nil
+}
+
+// secretScanningError replaces the 404 that GitHub returns when secret scanning is disabled with a more helpful error
+func secretScanningError(err error, opts models.ListSecretScanningAlertsOptions) error {
+	var restErr *RESTError
+	if errors.As(err, &restErr) && restErr.StatusCode == http.StatusNotFound {
+		return errors.Wrapf(dserrors.ErrorSecretScanningDisabled, "%s/%s", opts.Owner, opts.Repository)
+	}
+
+	return errors.WithStack(err)
+}
@@ -0,0 +1,170 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+type errorRESTClient struct {
+	err error
+}
+
+func (c *errorRESTClient) Get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	return c.err
+}
+
+func (c *errorRESTClient) Post(ctx context.Context, path string, body interface{}, v interface{}) error {
+	return c.err
+}
+
+func TestGetAllSecretScanningAlerts(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListSecretScanningAlertsOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+			State:      "open",
+		}
+	)
+
+	client := testutil.NewTestRESTClient(t,
+		testutil.GetTestRequestFunction("/repos/grafana/grafana/secret-scanning/alerts", "state", "per_page", "page"),
+	)
+
+	_, err := GetAllSecretScanningAlerts(ctx, client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAllSecretScanningAlertsDisabled(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListSecretScanningAlertsOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+		}
+		client = &errorRESTClient{
+			err: &RESTError{StatusCode: http.StatusNotFound, Message: "Not Found"},
+		}
+	)
+
+	_, err := GetAllSecretScanningAlerts(ctx, client, opts)
+	if !errors.Is(err, dserrors.ErrorSecretScanningDisabled) {
+		t.Fatalf("Expected error '%s', received '%v'", dserrors.ErrorSecretScanningDisabled, err)
+	}
+}
+
+// secretScanningClient responds with two alerts that each have a single location, and counts the requests for the locations
+type secretScanningClient struct {
+	locationRequests int
+}
+
+func (c *secretScanningClient) Get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	if strings.HasSuffix(path, "/locations") {
+		c.locationRequests++
+		return json.Unmarshal([]byte(`[{"type": "commit"}]`), v)
+	}
+
+	return json.Unmarshal([]byte(`[{"number": 1}, {"number": 2}]`), v)
+}
+
+func (c *secretScanningClient) Post(ctx context.Context, path string, body interface{}, v interface{}) error {
+	return nil
+}
+
+func TestGetAllSecretScanningAlertsLocations(t *testing.T) {
+	opts := models.ListSecretScanningAlertsOptions{
+		Repository: "grafana",
+		Owner:      "grafana",
+	}
+
+	t.Run("the locations should not be requested by default", func(t *testing.T) {
+		client := &secretScanningClient{}
+		if _, err := GetAllSecretScanningAlerts(context.Background(), client, opts); err != nil {
+			t.Fatal(err)
+		}
+
+		if client.locationRequests != 0 {
+			t.Fatalf("Expected no requests for the locations, received %d", client.locationRequests)
+		}
+	})
+
+	t.Run("the locations should be counted if the IncludeLocations option is set", func(t *testing.T) {
+		client := &secretScanningClient{}
+		opts.IncludeLocations = true
+
+		alerts, err := GetAllSecretScanningAlerts(context.Background(), client, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if client.locationRequests != 2 {
+			t.Fatalf("Expected one request for the locations of every alert, received %d", client.locationRequests)
+		}
+
+		for _, v := range alerts {
+			if v.Locations != 1 {
+				t.Fatalf("Expected alert #%d to have 1 location, received %d", v.Number, v.Locations)
+			}
+		}
+	})
+}
+
+func TestSecretScanningAlertsDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolvedAt := createdAt.Add(6 * time.Hour)
+
+	alerts := SecretScanningAlerts{
+		{
+			Number:                1,
+			State:                 "open",
+			SecretType:            "github_personal_access_token",
+			SecretTypeDisplayName: "GitHub Personal Access Token",
+			HTMLURL:               "https://github.com/grafana/grafana/security/secret-scanning/1",
+			CreatedAt:             createdAt,
+			Locations:             2,
+		},
+		{
+			Number:                2,
+			State:                 "resolved",
+			Resolution:            "revoked",
+			SecretType:            "slack_api_token",
+			SecretTypeDisplayName: "Slack API Token",
+			HTMLURL:               "https://github.com/grafana/grafana/security/secret-scanning/2",
+			CreatedAt:             createdAt,
+			ResolvedAt:            &resolvedAt,
+			ResolvedBy:            &RESTUser{Login: "testUser"},
+			Locations:             1,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("secret_scanning_alerts", alerts); err != nil {
+		t.Fatal(err)
+	}
+
+	wrapper := SecretScanningAlertsWrapper{
+		Alerts: alerts,
+		Options: models.ListSecretScanningAlertsOptions{
+			IncludeLocations: true,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("secret_scanning_alerts_locations", wrapper); err != nil {
+		t.Fatal(err)
+	}
+}
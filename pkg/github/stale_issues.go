@@ -0,0 +1,116 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+	"github.com/shurcooL/githubv4"
+)
+
+// StaleIssue is an open GitHub issue that has not been updated for at least the configured number of days
+type StaleIssue struct {
+	Issue
+
+	// DaysStale is the number of whole days since the issue was last updated
+	DaysStale int64
+}
+
+// StaleIssues is a list of stale GitHub issues, sorted by the time they were last updated (oldest first)
+type StaleIssues []StaleIssue
+
+// Frames converts the list of stale issues to a Grafana DataFrame
+func (s StaleIssues) Frames() data.Frames {
+	frame := data.NewFrame(
+		"stale_issues",
+		data.NewField("title", nil, []string{}),
+		data.NewField("author", nil, []string{}),
+		data.NewField("repo", nil, []string{}),
+		data.NewField("number", nil, []int64{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+		data.NewField("days_stale", nil, []int64{}),
+	)
+
+	for _, v := range s {
+		frame.AppendRow(
+			v.Title,
+			v.Author.Login,
+			v.Repository.NameWithOwner,
+			v.Number,
+			v.CreatedAt.Time,
+			v.UpdatedAt.Time,
+			v.DaysStale,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// GetStaleIssues lists the open issues in a repository that have not been updated in the configured number of days before `now`.
+// The filtering and sorting is done by GitHub using the `updated:<` and `sort:updated-asc` search qualifiers.
+func GetStaleIssues(ctx context.Context, client Client, opts models.ListStaleIssuesOptions, now time.Time) (StaleIssues, error) {
+	days := opts.Days
+	if days <= 0 {
+		days = models.DefaultStaleIssueDays
+	}
+
+	threshold := now.AddDate(0, 0, -int(days))
+
+	search := []string{
+		"is:issue",
+		"is:open",
+		fmt.Sprintf("repo:%s/%s", opts.Owner, opts.Repository),
+		fmt.Sprintf("updated:<%s", threshold.Format(time.RFC3339)),
+		"sort:updated-asc",
+	}
+
+	if opts.Query != nil {
+		search = append(search, *opts.Query)
+	}
+
+	var (
+		variables = map[string]interface{}{
+			"cursor":               (*githubv4.String)(nil),
+			"query":                githubv4.String(strings.Join(search, " ")),
+			"includeBody":          githubv4.Boolean(false),
+			"includeReopenedCount": githubv4.Boolean(false),
+		}
+
+		issues = StaleIssues{}
+	)
+
+	for {
+		q := &QuerySearchIssues{}
+		if err := client.Query(ctx, q, variables); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, v := range q.Search.Nodes {
+			if opts.ExcludeBots && v.Issue.Author.IsBot() {
+				continue
+			}
+
+			issues = append(issues, StaleIssue{
+				Issue:     v.Issue,
+				DaysStale: daysBetween(v.Issue.UpdatedAt.Time, now),
+			})
+		}
+
+		if !q.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = q.Search.PageInfo.EndCursor
+	}
+
+	return issues, nil
+}
+
+// daysBetween returns the number of whole days between two times
+func daysBetween(from time.Time, to time.Time) int64 {
+	return int64(to.Sub(from).Hours() / 24)
+}
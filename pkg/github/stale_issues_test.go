@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestGetStaleIssues(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.ListStaleIssuesOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+			Days:       30,
+		}
+		now = time.Date(2020, time.September, 30, 12, 0, 0, 0, time.UTC)
+	)
+
+	testVariables := func(t *testing.T, m map[string]interface{}) {
+		testutil.EnsureKeysAreSet(t, m, "query", "cursor")
+
+		query := string(m["query"].(githubv4.String))
+		for _, v := range []string{"is:issue", "is:open", "repo:grafana/grafana", "updated:<2020-08-31T12:00:00Z", "sort:updated-asc"} {
+			if !strings.Contains(query, v) {
+				t.Errorf("expected search query '%s' to contain '%s'", query, v)
+			}
+		}
+	}
+
+	client := testutil.NewTestClient(t,
+		testVariables,
+		testutil.GetTestQueryFunction(&QuerySearchIssues{}),
+	)
+
+	_, err := GetStaleIssues(ctx, client, opts, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStaleIssuesDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-03-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := createdAt.AddDate(0, 6, 0)
+
+	issues := StaleIssues{}
+	for i, updatedAt := range []time.Time{createdAt, createdAt.AddDate(0, 1, 0), createdAt.AddDate(0, 2, 12)} {
+		issue := Issue{
+			Number: int64(i + 1),
+			Title:  "Stale issue",
+			CreatedAt: githubv4.DateTime{
+				Time: createdAt,
+			},
+			UpdatedAt: githubv4.DateTime{
+				Time: updatedAt,
+			},
+			Author: Author{
+				Typename: "User",
+				Login:    "firstUser",
+				User: User{
+					Login: "firstUser",
+				},
+			},
+			Repository: Repository{
+				NameWithOwner: "grafana/grafana",
+			},
+		}
+
+		issues = append(issues, StaleIssue{
+			Issue:     issue,
+			DaysStale: daysBetween(updatedAt, now),
+		})
+	}
+
+	if err := testutil.CheckGoldenFramer("stale_issues", issues); err != nil {
+		t.Fatal(err)
+	}
+}
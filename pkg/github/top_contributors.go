@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// TopContributor is a GitHub user along with the number of issues and pull requests they opened and commits they authored in a time range
+type TopContributor struct {
+	Login        string
+	Issues       int64
+	PullRequests int64
+	Commits      int64
+}
+
+// Total returns the sum of the issues, pull requests, and commits of the contributor
+func (c TopContributor) Total() int64 {
+	return c.Issues + c.PullRequests + c.Commits
+}
+
+// TopContributors is a list of contributors, sorted by their total activity
+type TopContributors []TopContributor
+
+// Frames converts the list of contributors to a Grafana DataFrame
+func (c TopContributors) Frames() data.Frames {
+	return TopContributorsWrapper{Contributors: c}.Frames()
+}
+
+// TopContributorsWrapper is a list of contributors along with the number of issues and pull requests that matched their searches
+type TopContributorsWrapper struct {
+	Contributors TopContributors
+
+	// IssueCount is the number of issues that matched the largest search. If it is larger than SearchResultLimit, a notice is added to the frame because some issues were not counted
+	IssueCount int64
+
+	// PullRequestCount is the number of pull requests that matched the search. If it is larger than SearchResultLimit, a notice is added to the frame because some pull requests were not counted
+	PullRequestCount int64
+}
+
+// Frames converts the list of contributors to a Grafana DataFrame
+func (w TopContributorsWrapper) Frames() data.Frames {
+	frame := data.NewFrame(
+		"top_contributors",
+		data.NewField("rank", nil, []int64{}),
+		data.NewField("login", nil, []string{}),
+		data.NewField("issues", nil, []int64{}),
+		data.NewField("pull_requests", nil, []int64{}),
+		data.NewField("commits", nil, []int64{}),
+		data.NewField("total", nil, []int64{}),
+	)
+	frame.Meta = noticesMeta(
+		searchLimitNotice(w.IssueCount, "issues", "Narrow the time range to count the issues of every contributor"),
+		searchLimitNotice(w.PullRequestCount, "pull requests", "Narrow the time range to count the pull requests of every contributor"),
+	)
+
+	for i, v := range w.Contributors {
+		frame.AppendRow(
+			int64(i+1),
+			v.Login,
+			v.Issues,
+			v.PullRequests,
+			v.Commits,
+			v.Total(),
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// topContributorsCounter counts the activity of every login, keeping the contributors in the order they were first seen
+type topContributorsCounter struct {
+	contributors TopContributors
+	index        map[string]int
+}
+
+// get returns the contributor with the login, adding it if it was not seen yet
+func (c *topContributorsCounter) get(login string) *TopContributor {
+	i, ok := c.index[login]
+	if !ok {
+		i = len(c.contributors)
+		c.index[login] = i
+		c.contributors = append(c.contributors, TopContributor{Login: login})
+	}
+
+	return &c.contributors[i]
+}
+
+// rank sorts the contributors by their total activity and removes the ones below the MinActivity and Limit options
+func (c *topContributorsCounter) rank(opts models.ListTopContributorsOptions) TopContributors {
+	contributors := TopContributors{}
+	for _, v := range c.contributors {
+		if v.Total() >= opts.MinActivity {
+			contributors = append(contributors, v)
+		}
+	}
+
+	// Contributors with the same total are sorted by their login, so that the ranking does not change between refreshes
+	sort.SliceStable(contributors, func(i, j int) bool {
+		if contributors[i].Total() != contributors[j].Total() {
+			return contributors[i].Total() > contributors[j].Total()
+		}
+		return contributors[i].Login < contributors[j].Login
+	})
+
+	if opts.Limit > 0 && int64(len(contributors)) > opts.Limit {
+		contributors = contributors[:opts.Limit]
+	}
+
+	return contributors
+}
+
+// GetTopContributors ranks the contributors of a repository by the number of issues and pull requests they opened and the commits they authored in the time range.
+// The activity is combined from the issues, pull requests, and commit authors queries. Commits are only counted for the authors that are linked to a GitHub user,
+// and only the commits of the Ref option, or of the default branch if it is empty, are counted.
+// The time range of the issue search is split like with the AutoSplitRange option of the issues query, so that issues are not missing because of the limit of GitHub's search API
+// The pull request search is not split, so a notice is added to the frame if it matches more pull requests than GitHub's search API returns
+func GetTopContributors(ctx context.Context, client Client, opts models.ListTopContributorsOptions, from time.Time, to time.Time) (TopContributorsWrapper, error) {
+	counter := &topContributorsCounter{
+		contributors: TopContributors{},
+		index:        map[string]int{},
+	}
+
+	issues, count, err := searchIssues(ctx, client, models.ListIssuesOptions{
+		Owner:          opts.Owner,
+		Repository:     opts.Repository,
+		TimeField:      models.IssueCreatedAt,
+		AutoSplitRange: true,
+	}, from, to)
+	if err != nil {
+		return TopContributorsWrapper{}, err
+	}
+
+	for _, v := range issues {
+		if v.Author.Login != "" {
+			counter.get(v.Author.Login).Issues++
+		}
+	}
+
+	pullRequests, pullRequestCount, err := searchPullRequestsInRange(ctx, client, models.ListPullRequestsOptions{
+		Owner:      opts.Owner,
+		Repository: opts.Repository,
+		TimeField:  models.PullRequestCreatedAt,
+		Fields:     []string{"author_login"},
+	}, from, to)
+	if err != nil {
+		return TopContributorsWrapper{}, err
+	}
+
+	for _, v := range pullRequests {
+		if v.Author.Login != "" {
+			counter.get(v.Author.Login).PullRequests++
+		}
+	}
+
+	// The commits query does not pick a branch by itself, so the commits of the default branch are counted if no ref is set
+	ref, err := refOrDefaultBranch(ctx, client, opts.Owner, opts.Repository, opts.Ref)
+	if err != nil {
+		return TopContributorsWrapper{}, err
+	}
+
+	authors, err := GetCommitAuthorsInRange(ctx, client, models.ListCommitAuthorsOptions{
+		Owner:      opts.Owner,
+		Repository: opts.Repository,
+		Ref:        ref,
+	}, from, to)
+	if err != nil {
+		return TopContributorsWrapper{}, err
+	}
+
+	for _, v := range authors.Authors {
+		if v.Author.User.Login != "" {
+			counter.get(v.Author.User.Login).Commits += v.Commits
+		}
+	}
+
+	return TopContributorsWrapper{Contributors: counter.rank(opts), IssueCount: count, PullRequestCount: pullRequestCount}, nil
+}
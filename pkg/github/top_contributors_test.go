@@ -0,0 +1,121 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/shurcooL/githubv4"
+)
+
+// topContributorsClient responds to the issues, pull requests, and commit authors queries with a single page each
+type topContributorsClient struct {
+	issues       []string
+	pullRequests []string
+	commits      []GitActor
+
+	// issueCount and pullRequestCount are the number of issues and pull requests that match their searches
+	issueCount       int64
+	pullRequestCount int64
+
+	// ref is the git reference of the commit authors query
+	ref string
+}
+
+func (c *topContributorsClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	switch query := q.(type) {
+	case *QuerySearchIssues:
+		query.Search.IssueCount = c.issueCount
+		for _, login := range c.issues {
+			query.Search.Nodes = append(query.Search.Nodes, struct {
+				Issue       Issue `graphql:"... on Issue"`
+				PullRequest Issue `graphql:"... on PullRequest"`
+			}{Issue: Issue{Author: Author{Login: login}, Typename: "Issue"}})
+		}
+	case *QueryListPullRequests:
+		query.Search.IssueCount = c.pullRequestCount
+		for _, login := range c.pullRequests {
+			query.Search.Nodes = append(query.Search.Nodes, struct {
+				PullRequest PullRequest `graphql:"... on PullRequest"`
+			}{PullRequest: PullRequest{Author: Author{Login: login}}})
+		}
+	case *QueryRepositoryID:
+		query.Repository.DefaultBranchRef = &struct{ Name string }{Name: "main"}
+	case *QueryListCommitAuthorsInRange:
+		c.ref = string(variables["ref"].(githubv4.String))
+		for _, author := range c.commits {
+			query.Repository.Object.Commit.History.Nodes = append(query.Repository.Object.Commit.History.Nodes, authorCommit{Author: author})
+		}
+	}
+	return nil
+}
+
+func TestTopContributorsDataframe(t *testing.T) {
+	var (
+		first  = GitActor{Name: "First User", Email: "first@example.com", User: User{Login: "firstUser"}}
+		second = GitActor{Name: "Second User", Email: "second@example.com", User: User{Login: "secondUser"}}
+		// Commits of authors that are not linked to a GitHub user can not be attributed to a login
+		unlinked = GitActor{Name: "Unlinked User", Email: "unlinked@example.com"}
+	)
+
+	client := &topContributorsClient{
+		issues:       []string{"thirdUser", "firstUser", "thirdUser", "fourthUser"},
+		pullRequests: []string{"secondUser", "thirdUser"},
+		commits:      []GitActor{second, first, second, unlinked, unlinked, unlinked},
+	}
+
+	opts := models.ListTopContributorsOptions{
+		Owner:       "grafana",
+		Repository:  "grafana",
+		Limit:       3,
+		MinActivity: 2,
+	}
+
+	contributors, err := GetTopContributors(context.Background(), client, opts, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testutil.CheckGoldenFramer("top_contributors", contributors); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.ref != "main" {
+		t.Fatalf("Expected the commits of the default branch to be counted, received '%s'", client.ref)
+	}
+}
+
+func TestTopContributorsRef(t *testing.T) {
+	client := &topContributorsClient{}
+	opts := models.ListTopContributorsOptions{Owner: "grafana", Repository: "grafana", Ref: "release-7.0"}
+
+	if _, err := GetTopContributors(context.Background(), client, opts, time.Time{}, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.ref != "release-7.0" {
+		t.Fatalf("Expected the commits of the ref to be counted, received '%s'", client.ref)
+	}
+}
+
+func TestTopContributorsSearchLimit(t *testing.T) {
+	opts := models.ListTopContributorsOptions{Owner: "grafana", Repository: "grafana"}
+
+	for name, client := range map[string]*topContributorsClient{
+		"issues":        {issues: []string{"firstUser"}, issueCount: 2500},
+		"pull requests": {pullRequests: []string{"firstUser"}, pullRequestCount: 2500},
+	} {
+		contributors, err := GetTopContributors(context.Background(), client, opts, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		meta := contributors.Frames()[0].Meta
+		if meta == nil || len(meta.Notices) != 1 || !strings.Contains(meta.Notices[0].Text, "2500 "+name) {
+			t.Fatalf("Expected a notice about the search limit of the %s, received %v", name, meta)
+		}
+	}
+}
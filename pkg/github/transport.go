@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+)
+
+// DevPluginVersion is the version of the plugin when it is not known, like when the backend runs outside of a plugin build
+const DevPluginVersion = "dev"
+
+// PluginVersion is the version of the plugin that is sent in the User-Agent of every request. LoadPluginVersion sets it when the plugin starts,
+// and it can also be set at build time with `-ldflags "-X github.com/grafana/github-datasource/pkg/github.PluginVersion=1.0.0"`
+var PluginVersion = DevPluginVersion
+
+// pluginJSONVersion reads the version from the plugin.json file in dir.
+// The frontend build replaces the %VERSION% placeholder of src/plugin.json with the version in package.json when it copies the file to dist, which is where the backend executables are built too
+func pluginJSONVersion(dir string) (string, bool) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, "plugin.json"))
+	if err != nil {
+		return "", false
+	}
+
+	plugin := struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+	}{}
+
+	if err := json.Unmarshal(b, &plugin); err != nil {
+		return "", false
+	}
+
+	version := plugin.Info.Version
+	if version == "" || strings.Contains(version, "%") {
+		return "", false
+	}
+
+	return version, true
+}
+
+// loadPluginVersion sets PluginVersion to the version in the plugin.json file in dir, unless it was set at build time
+func loadPluginVersion(dir string) {
+	if PluginVersion != DevPluginVersion {
+		return
+	}
+
+	if version, ok := pluginJSONVersion(dir); ok {
+		PluginVersion = version
+	}
+}
+
+// LoadPluginVersion sets PluginVersion to the version in the plugin.json file next to the plugin executable. It is called once when the plugin starts
+func LoadPluginVersion() {
+	exe, err := os.Executable()
+	if err != nil {
+		log.DefaultLogger.Warn("the plugin version could not be found", "error", err.Error())
+		return
+	}
+
+	loadPluginVersion(filepath.Dir(exe))
+}
+
+// userAgent returns the User-Agent that is sent to GitHub. The custom User-Agent from the datasource settings is prepended to the plugin name and version
+func userAgent(custom string) string {
+	ua := fmt.Sprintf("grafana-github-datasource/%s", PluginVersion)
+	if custom == "" {
+		return ua
+	}
+
+	return fmt.Sprintf("%s %s", custom, ua)
+}
+
+// requestIDsKey is the context key of the requestIDs that collect the GitHub request IDs of the requests sent with a context
+type requestIDsKey struct{}
+
+// requestIDs collects the `X-GitHub-Request-Id` response headers of the requests sent with a context
+type requestIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// withRequestIDs returns a context that collects the GitHub request IDs of every request sent with it
+func withRequestIDs(ctx context.Context, ids *requestIDs) context.Context {
+	return context.WithValue(ctx, requestIDsKey{}, ids)
+}
+
+func (r *requestIDs) add(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, id)
+}
+
+func (r *requestIDs) list() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.ids...)
+}
+
+// transport sets the User-Agent of every request sent to GitHub, and logs the GitHub request ID of every response at debug level.
+// The request ID is what GitHub support needs to find a request.
+type transport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+// newTransport wraps the base RoundTripper (or http.DefaultTransport if it is nil) in a transport
+func newTransport(base http.RoundTripper, userAgent string) *transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &transport{
+		base:      base,
+		userAgent: userAgent,
+	}
+}
+
+// RoundTrip sends the request with the User-Agent header set
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper should not modify the request
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	id := res.Header.Get("X-GitHub-Request-Id")
+	log.DefaultLogger.Debug("github request", "method", req.Method, "path", req.URL.Path, "status", res.StatusCode, "requestId", id)
+
+	if ids, ok := req.Context().Value(requestIDsKey{}).(*requestIDs); ok && id != "" {
+		ids.add(id)
+	}
+
+	return res, nil
+}
+
+// newTLSTransport returns a copy of http.DefaultTransport that trusts the PEM encoded CA certificate in addition to the system certificates, and skips the verification of the server certificate if skipVerify is set.
+// It returns nil if neither is set, so that the default transport is used.
+func newTLSTransport(caCert string, skipVerify bool) (http.RoundTripper, error) {
+	if strings.TrimSpace(caCert) == "" && !skipVerify {
+		return nil, nil
+	}
+
+	config := &tls.Config{}
+
+	if strings.TrimSpace(caCert) != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, dserrors.ErrorInvalidCACert
+		}
+		config.RootCAs = pool
+	}
+
+	if skipVerify {
+		log.DefaultLogger.Warn("TLS certificate verification is disabled for this GitHub datasource. The requests to GitHub, including the access token, can be intercepted. Only use this for testing")
+		config.InsecureSkipVerify = true
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = config
+
+	return t, nil
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/pkg/errors"
+)
+
+func TestUserAgent(t *testing.T) {
+	if ua := userAgent(""); ua != "grafana-github-datasource/dev" {
+		t.Errorf("Unexpected default User-Agent '%s'", ua)
+	}
+
+	if ua := userAgent("acme-grafana"); ua != "acme-grafana grafana-github-datasource/dev" {
+		t.Errorf("Unexpected custom User-Agent '%s'", ua)
+	}
+}
+
+// TestUserAgentPluginVersion builds the plugin.json of the dist folder like the frontend build does, with the version of package.json, and checks that the User-Agent uses that version
+func TestUserAgentPluginVersion(t *testing.T) {
+	pkg, err := ioutil.ReadFile("../../package.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var packageJSON struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(pkg, &packageJSON); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := ioutil.ReadFile("../../src/plugin.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dist, err := ioutil.TempDir("", "dist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dist)
+
+	pluginJSON := strings.ReplaceAll(string(src), "%VERSION%", packageJSON.Version)
+	if err := ioutil.WriteFile(filepath.Join(dist, "plugin.json"), []byte(pluginJSON), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() { PluginVersion = DevPluginVersion }()
+	loadPluginVersion(dist)
+
+	if PluginVersion == DevPluginVersion || PluginVersion != packageJSON.Version {
+		t.Fatalf("Expected the version %s from plugin.json, received %s", packageJSON.Version, PluginVersion)
+	}
+
+	if ua := userAgent(""); ua != "grafana-github-datasource/"+packageJSON.Version {
+		t.Errorf("Unexpected User-Agent '%s'", ua)
+	}
+}
+
+func TestPluginJSONVersionPlaceholder(t *testing.T) {
+	// The version of src/plugin.json is a placeholder until the frontend build replaces it
+	if version, ok := pluginJSONVersion("../../src"); ok {
+		t.Fatalf("Expected the placeholder version to be ignored, received %s", version)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != "acme-grafana grafana-github-datasource/dev" {
+			t.Errorf("Unexpected User-Agent '%s'", ua)
+		}
+
+		w.Header().Set("X-GitHub-Request-Id", "C4E6:1234:ABCD")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: newTransport(srv.Client().Transport, userAgent("acme-grafana")),
+	}
+
+	ids := &requestIDs{}
+	req, err := http.NewRequestWithContext(withRequestIDs(context.Background(), ids), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if req.Header.Get("User-Agent") != "" {
+		t.Errorf("Expected the original request to be unchanged")
+	}
+
+	if list := ids.list(); len(list) != 1 || list[0] != "C4E6:1234:ABCD" {
+		t.Fatalf("Expected the request ID to be collected, received %v", list)
+	}
+}
+
+func TestTLSTransport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	get := func(base http.RoundTripper) error {
+		res, err := (&http.Client{Transport: base}).Get(srv.URL)
+		if err != nil {
+			return err
+		}
+		return res.Body.Close()
+	}
+
+	t.Run("the default transport should be used if no CA or skip verify is set", func(t *testing.T) {
+		base, err := newTLSTransport("", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if base != nil {
+			t.Fatalf("Expected no transport, received %v", base)
+		}
+	})
+
+	t.Run("the server certificate should be trusted if it is signed by the CA", func(t *testing.T) {
+		ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+		base, err := newTLSTransport(string(ca), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := get(base); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("the server certificate should not be verified if skip verify is set", func(t *testing.T) {
+		base, err := newTLSTransport("", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := get(base); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("an invalid CA certificate should return an error", func(t *testing.T) {
+		_, err := newTLSTransport("not a certificate", false)
+		if !errors.Is(err, dserrors.ErrorInvalidCACert) {
+			t.Fatalf("Expected error '%s', received '%v'", dserrors.ErrorInvalidCACert, err)
+		}
+	})
+}
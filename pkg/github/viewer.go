@@ -0,0 +1,40 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/pkg/errors"
+)
+
+// QueryViewer is the GraphQL query for the login of the authenticated user
+// {
+//   viewer {
+//     login
+//   }
+// }
+type QueryViewer struct {
+	Viewer struct {
+		Login string
+	}
+}
+
+// GetViewer returns the login of the user that the access token belongs to
+func GetViewer(ctx context.Context, client Client) (string, error) {
+	q := &QueryViewer{}
+	if err := client.Query(ctx, q, nil); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return q.Viewer.Login, nil
+}
+
+// viewerQualifier returns the search qualifier that limits a search to the issues or pull requests related to the authenticated user, or an empty string
+func viewerQualifier(relation models.ViewerRelation) string {
+	if relation == models.ViewerNone {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:@me", relation)
+}
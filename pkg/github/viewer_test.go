@@ -0,0 +1,42 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+func TestGetViewer(t *testing.T) {
+	client := testutil.NewTestClient(t,
+		nil,
+		testutil.GetTestQueryFunction(&QueryViewer{}),
+	)
+
+	if _, err := GetViewer(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestViewerQualifier(t *testing.T) {
+	t.Run("No qualifier should be added if the search is not limited to the viewer", func(t *testing.T) {
+		if q := viewerQualifier(models.ViewerNone); q != "" {
+			t.Fatalf("Expected no qualifier, received '%s'", q)
+		}
+	})
+
+	t.Run("Pull requests that the viewer was asked to review should use the review-requested qualifier", func(t *testing.T) {
+		var (
+			result = buildQuery(models.ListPullRequestsOptions{
+				Owner:      "grafana",
+				Repository: "github-datasource",
+				Viewer:     models.ViewerReviewRequested,
+			})
+			expect = "is:pr repo:grafana/github-datasource review-requested:@me"
+		)
+		if result != expect {
+			t.Fatalf("Unexpected result from buildQuery. Expected '%s', received '%s'", expect, result)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// WebhookResponse is the response that a webhook received for its last delivery
+type WebhookResponse struct {
+	Code   *int64 `json:"code"`
+	Status string `json:"status"`
+}
+
+// Webhook is a GitHub webhook of a repository or organization
+type Webhook struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Active    bool      `json:"active"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Config    struct {
+		URL string `json:"url"`
+	} `json:"config"`
+
+	// LastResponse is part of the repository webhooks. For organization webhooks, it is filled using the last delivery of the webhook
+	LastResponse *WebhookResponse `json:"last_response"`
+}
+
+// Webhooks is a list of GitHub webhooks
+type Webhooks []Webhook
+
+// Frames converts the list of webhooks to a Grafana DataFrame
+func (w Webhooks) Frames() data.Frames {
+	frame := data.NewFrame(
+		"webhooks",
+		data.NewField("id", nil, []int64{}),
+		data.NewField("name", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("events", nil, []string{}),
+		data.NewField("active", nil, []bool{}),
+		data.NewField("last_response_code", nil, []*int64{}),
+		data.NewField("last_response_status", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+	)
+
+	for _, v := range w {
+		var (
+			code   *int64
+			status string
+		)
+
+		if v.LastResponse != nil {
+			code = v.LastResponse.Code
+			status = v.LastResponse.Status
+		}
+
+		frame.AppendRow(
+			v.ID,
+			v.Name,
+			v.Config.URL,
+			strings.Join(v.Events, ","),
+			v.Active,
+			code,
+			status,
+			v.CreatedAt,
+			v.UpdatedAt,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// webhooksPath returns the REST path of the webhooks of a repository, or the organization if no repository is set
+func webhooksPath(opts models.ListWebhooksOptions) string {
+	if opts.Repository == "" {
+		return fmt.Sprintf("/orgs/%s/hooks", opts.Owner)
+	}
+
+	return fmt.Sprintf("/repos/%s/%s/hooks", opts.Owner, opts.Repository)
+}
+
+// GetAllWebhooks lists the webhooks of a repository or organization using the REST API: /repos/{owner}/{repo}/hooks or /orgs/{org}/hooks
+// Organization webhooks do not include the response to their last delivery, so it is retrieved with an additional request per webhook.
+func GetAllWebhooks(ctx context.Context, client RESTClient, opts models.ListWebhooksOptions) (Webhooks, error) {
+	var (
+		path   = webhooksPath(opts)
+		params = url.Values{
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+
+		hooks = Webhooks{}
+	)
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		h := Webhooks{}
+		if err := client.Get(ctx, path, params, &h); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		hooks = append(hooks, h...)
+
+		if len(h) < RESTPageSize {
+			break
+		}
+	}
+
+	for i, v := range hooks {
+		if v.LastResponse != nil {
+			continue
+		}
+
+		res, err := getLastWebhookDelivery(ctx, client, path, v.ID)
+		if err != nil {
+			return nil, err
+		}
+		hooks[i].LastResponse = res
+	}
+
+	return hooks, nil
+}
+
+// getLastWebhookDelivery returns the response to the last delivery of a webhook: {path}/{id}/deliveries, or nil if the webhook was never delivered
+func getLastWebhookDelivery(ctx context.Context, client RESTClient, hooksPath string, id int64) (*WebhookResponse, error) {
+	deliveries := []struct {
+		Status     string `json:"status"`
+		StatusCode int64  `json:"status_code"`
+	}{}
+
+	params := url.Values{
+		"per_page": []string{"1"},
+	}
+	if err := client.Get(ctx, fmt.Sprintf("%s/%d/deliveries", hooksPath, id), params, &deliveries); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+
+	return &WebhookResponse{
+		Code:   &deliveries[0].StatusCode,
+		Status: deliveries[0].Status,
+	}, nil
+}
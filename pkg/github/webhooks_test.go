@@ -0,0 +1,104 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+func TestGetAllWebhooks(t *testing.T) {
+	t.Run("repository webhooks should be listed if a repository is set", func(t *testing.T) {
+		client := testutil.NewTestRESTClient(t,
+			testutil.GetTestRequestFunction("/repos/grafana/grafana/hooks", "per_page", "page"),
+		)
+
+		_, err := GetAllWebhooks(context.Background(), client, models.ListWebhooksOptions{Owner: "grafana", Repository: "grafana"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("organization webhooks should be listed if no repository is set", func(t *testing.T) {
+		client := testutil.NewTestRESTClient(t,
+			testutil.GetTestRequestFunction("/orgs/grafana/hooks", "per_page", "page"),
+		)
+
+		_, err := GetAllWebhooks(context.Background(), client, models.ListWebhooksOptions{Owner: "grafana"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGetAllWebhooksLastDelivery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/orgs/grafana/hooks":
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "web", "active": true, "events": ["push"], "config": {"url": "https://example.com/hook"}}, {"id": 2, "name": "web", "active": true, "events": ["push"], "config": {"url": "https://example.com/new"}}]`))
+		case "/api/v3/orgs/grafana/hooks/1/deliveries":
+			_, _ = w.Write([]byte(`[{"id": 10, "status": "Service Unavailable", "status_code": 503}]`))
+		case "/api/v3/orgs/grafana/hooks/2/deliveries":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	hooks, err := GetAllWebhooks(context.Background(), newRESTClient(srv.Client(), srv.URL), models.ListWebhooksOptions{Owner: "grafana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hooks) != 2 {
+		t.Fatalf("Expected 2 webhooks, received %d", len(hooks))
+	}
+
+	if res := hooks[0].LastResponse; res == nil || res.Code == nil || *res.Code != 503 || res.Status != "Service Unavailable" {
+		t.Fatalf("Expected the response of the last delivery of the first webhook, received %+v", res)
+	}
+
+	if hooks[1].LastResponse != nil {
+		t.Fatalf("Expected no response for a webhook that was never delivered, received %+v", hooks[1].LastResponse)
+	}
+}
+
+func TestWebhooksDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok := int64(200)
+
+	hooks := Webhooks{
+		{
+			ID:           1,
+			Name:         "web",
+			Active:       true,
+			Events:       []string{"push", "pull_request"},
+			CreatedAt:    createdAt,
+			UpdatedAt:    createdAt.Add(time.Hour),
+			LastResponse: &WebhookResponse{Code: &ok, Status: "active"},
+		},
+		{
+			ID:           2,
+			Name:         "web",
+			Events:       []string{"release"},
+			CreatedAt:    createdAt,
+			UpdatedAt:    createdAt,
+			LastResponse: &WebhookResponse{Status: "unused"},
+		},
+	}
+	hooks[0].Config.URL = "https://example.com/hook"
+	hooks[1].Config.URL = "https://example.com/releases"
+
+	if err := testutil.CheckGoldenFramer("webhooks", hooks); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/pkg/errors"
+)
+
+// workflowDispatchRequest is the body of a workflow dispatch request to the REST API
+type workflowDispatchRequest struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// DispatchWorkflow triggers a GitHub Actions workflow run using the REST API: /repos/{owner}/{repo}/actions/workflows/{workflow}/dispatches
+// GitHub validates the inputs against the workflow's `workflow_dispatch` inputs; a missing required input or an unknown input is returned as an error.
+func DispatchWorkflow(ctx context.Context, client RESTClient, opts models.WorkflowDispatchOptions) error {
+	if opts.Ref == "" {
+		return dserrors.ErrorWorkflowRefMissing
+	}
+
+	// The owner and repository come from the request body, and must not be able to send the request to another REST endpoint
+	for _, v := range []string{opts.Owner, opts.Repository, opts.Workflow} {
+		if !validPathSegment(v) {
+			return errors.Wrapf(dserrors.ErrorInvalidPathSegment, "'%s'", v)
+		}
+	}
+
+	path := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/dispatches", url.PathEscape(opts.Owner), url.PathEscape(opts.Repository), url.PathEscape(opts.Workflow))
+
+	body := workflowDispatchRequest{
+		Ref:    opts.Ref,
+		Inputs: opts.Inputs,
+	}
+
+	if err := client.Post(ctx, path, body, nil); err != nil {
+		var restErr *RESTError
+		if errors.As(err, &restErr) && restErr.StatusCode == http.StatusUnprocessableEntity {
+			return errors.Wrapf(err, "invalid inputs for workflow '%s'", opts.Workflow)
+		}
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// validPathSegment returns false if the value could change the path of a REST request if it is used as one of its segments
+func validPathSegment(v string) bool {
+	return !strings.ContainsAny(v, "/?#\\") && !strings.Contains(v, "..")
+}
@@ -0,0 +1,124 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	dserrors "github.com/grafana/github-datasource/pkg/errors"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+	"github.com/pkg/errors"
+)
+
+func TestDispatchWorkflow(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		opts = models.WorkflowDispatchOptions{
+			Repository: "grafana",
+			Owner:      "grafana",
+			Workflow:   "deploy.yml",
+			Ref:        "main",
+			Inputs: map[string]string{
+				"environment": "production",
+			},
+		}
+	)
+
+	client := testutil.NewTestRESTClient(t, testutil.GetTestRequestFunction("/repos/grafana/grafana/actions/workflows/deploy.yml/dispatches"))
+	client.TestBody = func(t *testing.T, body interface{}) {
+		b, ok := body.(workflowDispatchRequest)
+		if !ok {
+			t.Fatalf("Unexpected body type %T", body)
+		}
+
+		if b.Ref != "main" || b.Inputs["environment"] != "production" {
+			t.Errorf("Unexpected body: %v", b)
+		}
+	}
+
+	if err := DispatchWorkflow(ctx, client, opts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDispatchWorkflowErrors(t *testing.T) {
+	t.Run("a ref is required", func(t *testing.T) {
+		err := DispatchWorkflow(context.Background(), testutil.NewTestRESTClient(t, nil), models.WorkflowDispatchOptions{Workflow: "deploy.yml"})
+		if !errors.Is(err, dserrors.ErrorWorkflowRefMissing) {
+			t.Fatalf("Expected ErrorWorkflowRefMissing, received '%v'", err)
+		}
+	})
+
+	t.Run("an owner or repository that changes the path is rejected", func(t *testing.T) {
+		for _, opts := range []models.WorkflowDispatchOptions{
+			{Owner: "x/../../orgs/foo", Repository: "grafana", Workflow: "deploy.yml", Ref: "main"},
+			{Owner: "grafana", Repository: "..", Workflow: "deploy.yml", Ref: "main"},
+			{Owner: "grafana", Repository: "grafana?per_page=1", Workflow: "deploy.yml", Ref: "main"},
+			{Owner: "grafana", Repository: "grafana#", Workflow: "deploy.yml", Ref: "main"},
+			{Owner: "grafana", Repository: "grafana", Workflow: "../../../deploy.yml", Ref: "main"},
+		} {
+			client := testutil.NewTestRESTClient(t, func(t *testing.T, path string, params url.Values) {
+				t.Errorf("Unexpected request to '%s'", path)
+			})
+
+			err := DispatchWorkflow(context.Background(), client, opts)
+			if !errors.Is(err, dserrors.ErrorInvalidPathSegment) {
+				t.Fatalf("Expected ErrorInvalidPathSegment for %s/%s, received '%v'", opts.Owner, opts.Repository, err)
+			}
+		}
+	})
+
+	t.Run("invalid inputs mention the workflow", func(t *testing.T) {
+		client := &errorRESTClient{
+			err: &RESTError{StatusCode: http.StatusUnprocessableEntity, Message: "Required input 'environment' not provided"},
+		}
+
+		err := DispatchWorkflow(context.Background(), client, models.WorkflowDispatchOptions{Workflow: "deploy.yml", Ref: "main"})
+		if err == nil || !strings.Contains(err.Error(), "deploy.yml") || !strings.Contains(err.Error(), "Required input 'environment' not provided") {
+			t.Fatalf("Unexpected error '%v'", err)
+		}
+	})
+}
+
+func TestHandleWorkflowDispatch(t *testing.T) {
+	body := `{"owner": "grafana", "repository": "grafana", "workflow": "deploy.yml", "ref": "main"}`
+
+	t.Run("triggering workflows is forbidden unless it is enabled", func(t *testing.T) {
+		var (
+			d = &Datasource{restClient: testutil.NewTestRESTClient(t, nil)}
+			w = httptest.NewRecorder()
+			r = httptest.NewRequest(http.MethodPost, "/workflows/dispatch", strings.NewReader(body))
+		)
+
+		d.HandleWorkflowDispatch(w, r)
+
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("Unexpected status code. Expected %d, received %d", http.StatusForbidden, w.Code)
+		}
+	})
+
+	t.Run("workflows are triggered when it is enabled", func(t *testing.T) {
+		var (
+			d = &Datasource{
+				restClient: testutil.NewTestRESTClient(t, func(t *testing.T, path string, params url.Values) {
+					if path != "/repos/grafana/grafana/actions/workflows/deploy.yml/dispatches" {
+						t.Errorf("Unexpected path '%s'", path)
+					}
+				}),
+				workflowDispatchEnabled: true,
+			}
+			w = httptest.NewRecorder()
+			r = httptest.NewRequest(http.MethodPost, "/workflows/dispatch", strings.NewReader(body))
+		)
+
+		d.HandleWorkflowDispatch(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Unexpected status code. Expected %d, received %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/pkg/errors"
+)
+
+// Workflow is a GitHub Actions workflow, defined by a file in the `.github/workflows` directory of a repository
+type Workflow struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+	State     string    `json:"state"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Workflows is a list of GitHub Actions workflows
+type Workflows []Workflow
+
+// Frames converts the list of workflows to a Grafana DataFrame
+func (w Workflows) Frames() data.Frames {
+	frame := data.NewFrame(
+		"workflows",
+		data.NewField("id", nil, []int64{}),
+		data.NewField("name", nil, []string{}),
+		data.NewField("path", nil, []string{}),
+		data.NewField("state", nil, []string{}),
+		data.NewField("url", nil, []string{}),
+		data.NewField("created_at", nil, []time.Time{}),
+		data.NewField("updated_at", nil, []time.Time{}),
+	)
+
+	for _, v := range w {
+		frame.AppendRow(
+			v.ID,
+			v.Name,
+			v.Path,
+			v.State,
+			v.HTMLURL,
+			v.CreatedAt,
+			v.UpdatedAt,
+		)
+	}
+
+	return data.Frames{frame}
+}
+
+// GetAllWorkflows lists the GitHub Actions workflows of a repository using the REST API: /repos/{owner}/{repo}/actions/workflows
+// The state of a workflow is "active", or one of the "disabled_manually", "disabled_inactivity", "disabled_fork" and "deleted" states.
+func GetAllWorkflows(ctx context.Context, client RESTClient, opts models.ListWorkflowsOptions) (Workflows, error) {
+	var (
+		path   = fmt.Sprintf("/repos/%s/%s/actions/workflows", opts.Owner, opts.Repository)
+		params = url.Values{
+			"per_page": []string{strconv.Itoa(RESTPageSize)},
+		}
+
+		workflows = Workflows{}
+	)
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		res := struct {
+			Workflows Workflows `json:"workflows"`
+		}{}
+		if err := client.Get(ctx, path, params, &res); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		workflows = append(workflows, res.Workflows...)
+
+		if len(res.Workflows) < RESTPageSize {
+			break
+		}
+	}
+
+	return workflows, nil
+}
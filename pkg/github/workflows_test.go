@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/github-datasource/pkg/testutil"
+)
+
+func TestGetAllWorkflows(t *testing.T) {
+	client := testutil.NewTestRESTClient(t,
+		testutil.GetTestRequestFunction("/repos/grafana/grafana/actions/workflows", "per_page", "page"),
+	)
+
+	_, err := GetAllWorkflows(context.Background(), client, models.ListWorkflowsOptions{Owner: "grafana", Repository: "grafana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWorkflowsDataframe(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2020-08-25T16:21:56+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workflows := Workflows{
+		{
+			ID:        161335,
+			Name:      "CI",
+			Path:      ".github/workflows/ci.yml",
+			State:     "active",
+			HTMLURL:   "https://github.com/grafana/grafana/blob/main/.github/workflows/ci.yml",
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt.Add(48 * time.Hour),
+		},
+		{
+			ID:        161336,
+			Name:      "Nightly",
+			Path:      ".github/workflows/nightly.yml",
+			State:     "disabled_inactivity",
+			HTMLURL:   "https://github.com/grafana/grafana/blob/main/.github/workflows/nightly.yml",
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		},
+	}
+
+	if err := testutil.CheckGoldenFramer("workflows", workflows); err != nil {
+		t.Fatal(err)
+	}
+}
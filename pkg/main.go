@@ -3,12 +3,15 @@ package main
 import (
 	"os"
 
+	"github.com/grafana/github-datasource/pkg/github"
 	"github.com/grafana/github-datasource/pkg/plugin"
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
 	"github.com/grafana/grafana-plugin-sdk-go/backend/datasource"
 )
 
 func main() {
+	github.LoadPluginVersion()
+
 	err := datasource.Serve(plugin.GetDatasourceOpts())
 
 	if err != nil {
@@ -0,0 +1,29 @@
+package models
+
+// DefaultActivityLimit is the number of issues and pull requests in the activity feed, when the limit is not set in the query
+const DefaultActivityLimit = 100
+
+// ListActivityOptions are the available options when listing the recently updated issues and pull requests of a repository or organization
+type ListActivityOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana). The issues and pull requests of every repository of the owner are returned if it is empty
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository, or the organization (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Query is added to the search (ex: "label:type/bug")
+	Query *string `json:"query,omitempty"`
+
+	// Limit is the number of most recently updated issues and pull requests that are returned. DefaultActivityLimit is used if it is not set
+	Limit int64 `json:"limit"`
+}
+
+// ActivityOptionsWithRepo adds the Owner and Repository options to a ListActivityOptions type. This is just for convenience
+func ActivityOptionsWithRepo(opt ListActivityOptions, owner string, repo string) ListActivityOptions {
+	return ListActivityOptions{
+		Owner:      owner,
+		Repository: repo,
+		Query:      opt.Query,
+		Limit:      opt.Limit,
+	}
+}
@@ -0,0 +1,29 @@
+package models
+
+// DefaultAuditLogLimit is the number of audit log events that are returned, when the limit is not set in the query
+const DefaultAuditLogLimit = 1000
+
+// ListAuditLogOptions are the available options when listing the audit log events of an organization
+type ListAuditLogOptions struct {
+	// Owner is the login of the organization (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Phrase is an audit log search phrase (ex: "action:repo.access" or "actor:octocat"). The time range of the query is added to it
+	Phrase string `json:"phrase,omitempty"`
+
+	// Include is the type of events that are returned: "web", "git", or "all". GitHub only returns web events if it is empty
+	Include string `json:"include,omitempty"`
+
+	// Limit is the number of most recent events that are returned. DefaultAuditLogLimit is used if it is not set
+	Limit int64 `json:"limit"`
+}
+
+// AuditLogOptionsWithOwner adds the Owner to a ListAuditLogOptions. This is just for convenience
+func AuditLogOptionsWithOwner(opt ListAuditLogOptions, owner string) ListAuditLogOptions {
+	return ListAuditLogOptions{
+		Owner:   owner,
+		Phrase:  opt.Phrase,
+		Include: opt.Include,
+		Limit:   opt.Limit,
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+// BucketInterval defines the size of the time buckets that results are grouped into (day, week, month)
+type BucketInterval string
+
+const (
+	// BucketNone is used when the results should not be grouped into time buckets
+	BucketNone BucketInterval = ""
+	// BucketDay groups results by day
+	BucketDay BucketInterval = "day"
+	// BucketWeek groups results by week. Weeks start on Monday
+	BucketWeek BucketInterval = "week"
+	// BucketMonth groups results by month
+	BucketMonth BucketInterval = "month"
+)
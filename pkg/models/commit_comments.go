@@ -0,0 +1,22 @@
+package models
+
+// ListCommitCommentsOptions are the available options when listing the commit comments of a repository
+type ListCommitCommentsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// BodyMaxLength is the number of characters after which the text of a comment is cut off. DefaultBodyMaxLength is used if it is not set
+	BodyMaxLength int64 `json:"bodyMaxLength"`
+}
+
+// CommitCommentsOptionsWithRepo adds the Owner and Repository options to a ListCommitCommentsOptions type. This is just for convenience
+func CommitCommentsOptionsWithRepo(opt ListCommitCommentsOptions, owner string, repo string) ListCommitCommentsOptions {
+	return ListCommitCommentsOptions{
+		Owner:         owner,
+		Repository:    repo,
+		BodyMaxLength: opt.BodyMaxLength,
+	}
+}
@@ -5,6 +5,13 @@ type ListCommitsOptions struct {
 	Repository string `json:"repository"`
 	Owner      string `json:"owner"`
 	Ref        string `json:"gitRef"`
+
+	// Refs is a comma separated list of branches (ex: "main,release-7.0"). If it is set, the commits of every branch are listed instead of the commits of Ref, and each commit is tagged with its branch
+	Refs string `json:"gitRefs,omitempty"`
+
+	// Cursor is the end cursor of a previous query (returned in the frame's custom meta data). If it is set, the history continues after the last page of that query instead of starting at the first page.
+	// It is not used if Refs is set
+	Cursor string `json:"cursor,omitempty"`
 }
 
 // CommitsOptionsWithRepo adds Owner and Repo to a ListCommitsOptions. This is just for convenience
@@ -13,5 +20,31 @@ func CommitsOptionsWithRepo(opt ListCommitsOptions, owner string, repo string) L
 		Owner:      owner,
 		Repository: repo,
 		Ref:        opt.Ref,
+		Refs:       opt.Refs,
+		Cursor:     opt.Cursor,
+	}
+}
+
+// ListCommitAuthorsOptions provides options when counting the commits per author
+type ListCommitAuthorsOptions struct {
+	Repository string `json:"repository"`
+	Owner      string `json:"owner"`
+	Ref        string `json:"gitRef"`
+
+	// Stats adds the total number of added and deleted lines of every author
+	Stats bool `json:"stats"`
+
+	// Limit only returns the authors with the most commits. Every author is returned if it is not set
+	Limit int64 `json:"limit"`
+}
+
+// CommitAuthorsOptionsWithRepo adds Owner and Repo to a ListCommitAuthorsOptions. This is just for convenience
+func CommitAuthorsOptionsWithRepo(opt ListCommitAuthorsOptions, owner string, repo string) ListCommitAuthorsOptions {
+	return ListCommitAuthorsOptions{
+		Owner:      owner,
+		Repository: repo,
+		Ref:        opt.Ref,
+		Stats:      opt.Stats,
+		Limit:      opt.Limit,
 	}
 }
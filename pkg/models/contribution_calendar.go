@@ -0,0 +1,7 @@
+package models
+
+// ListContributionCalendarOptions are the available options when querying the contribution calendar of a user
+type ListContributionCalendarOptions struct {
+	// Login is the login of the user (ex: torkelo). The owner of the query is used if it is empty
+	Login string `json:"login"`
+}
@@ -0,0 +1,18 @@
+package models
+
+// ListDeployKeysOptions are the available options when listing the deploy keys of a repository
+type ListDeployKeysOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+}
+
+// DeployKeysOptionsWithRepo adds the Owner and Repository options to a ListDeployKeysOptions type. This is just for convenience
+func DeployKeysOptionsWithRepo(opt ListDeployKeysOptions, owner string, repo string) ListDeployKeysOptions {
+	return ListDeployKeysOptions{
+		Owner:      owner,
+		Repository: repo,
+	}
+}
@@ -0,0 +1,97 @@
+package models
+
+// ListDeploymentsOptions are the available options when listing the deployments of a repository
+type ListDeploymentsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Environments is a comma separated list of environments (ex: "production,staging"). Deployments to every environment are returned if it is empty
+	Environments string `json:"environments,omitempty"`
+
+	// FailedOnly only returns the deployments that are in a failed (FAILURE or ERROR) state
+	FailedOnly bool `json:"failedOnly"`
+}
+
+// DeploymentsOptionsWithRepo adds the Owner and Repository options to a ListDeploymentsOptions type. This is just for convenience
+func DeploymentsOptionsWithRepo(opt ListDeploymentsOptions, owner string, repo string) ListDeploymentsOptions {
+	return ListDeploymentsOptions{
+		Owner:        owner,
+		Repository:   repo,
+		Environments: opt.Environments,
+		FailedOnly:   opt.FailedOnly,
+	}
+}
+
+// ListDeploymentFrequencyOptions are the available options when counting the deployments to every environment of a repository
+type ListDeploymentFrequencyOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Environments is a comma separated list of environments (ex: "production,staging"). Deployments to every environment are counted if it is empty
+	Environments string `json:"environments,omitempty"`
+
+	// Bucket counts the deployments in day, week, or month buckets, with one frame per environment, instead of a single count per environment
+	Bucket BucketInterval `json:"bucket,omitempty"`
+
+	// Timezone is the IANA name of the time zone (ex: Europe/Berlin) that the buckets are aligned in. UTC is used if it is empty
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// DeploymentFrequencyOptionsWithRepo adds the Owner and Repository options to a ListDeploymentFrequencyOptions type. This is just for convenience
+func DeploymentFrequencyOptionsWithRepo(opt ListDeploymentFrequencyOptions, owner string, repo string) ListDeploymentFrequencyOptions {
+	return ListDeploymentFrequencyOptions{
+		Owner:        owner,
+		Repository:   repo,
+		Environments: opt.Environments,
+		Bucket:       opt.Bucket,
+		Timezone:     opt.Timezone,
+	}
+}
+
+// DefaultDeploymentFailureStates are the deployment states that count as a failure when computing the change failure rate, when they are not set in the query
+const DefaultDeploymentFailureStates = "FAILURE,ERROR"
+
+// ListChangeFailureRateOptions are the available options when computing the change failure rate of the deployments of a repository
+type ListChangeFailureRateOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Environments is a comma separated list of environments (ex: "production,staging"). Deployments to every environment are counted if it is empty
+	Environments string `json:"environments,omitempty"`
+
+	// FailureStates is a comma separated list of deployment states (ex: "FAILURE,ERROR,INACTIVE"). A deployment failed if it, or any of its statuses, has one of these states. DefaultDeploymentFailureStates is used if it is empty
+	FailureStates string `json:"failureStates,omitempty"`
+
+	// Bucket computes the change failure rate in day, week, or month buckets instead of a single rate for the time range
+	Bucket BucketInterval `json:"bucket,omitempty"`
+
+	// Timezone is the IANA name of the time zone (ex: Europe/Berlin) that the buckets are aligned in. UTC is used if it is empty
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// ChangeFailureRateOptionsWithRepo adds the Owner and Repository options to a ListChangeFailureRateOptions type. This is just for convenience
+func ChangeFailureRateOptionsWithRepo(opt ListChangeFailureRateOptions, owner string, repo string) ListChangeFailureRateOptions {
+	return ListChangeFailureRateOptions{
+		Owner:         owner,
+		Repository:    repo,
+		Environments:  opt.Environments,
+		FailureStates: opt.FailureStates,
+		Bucket:        opt.Bucket,
+		Timezone:      opt.Timezone,
+	}
+}
+
+// ListDeploymentStatusesOptions are the available options when listing the status history of a single deployment
+type ListDeploymentStatusesOptions struct {
+	// DeploymentID is the GraphQL node ID of the deployment, which is the `id` column of the deployments query
+	DeploymentID string `json:"deploymentId"`
+}
@@ -0,0 +1,35 @@
+package models
+
+// ListDiscussionsOptions are the available options when listing the discussions of a repository
+type ListDiscussionsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Query is added to the discussion search (ex: "author:octocat")
+	Query *string `json:"query,omitempty"`
+
+	// Category only returns the discussions in the category with this name or slug (ex: "q-a")
+	Category string `json:"category,omitempty"`
+
+	// AnsweredOnly only returns the discussions that have an answer if it is true, and the discussions without an answer if it is false.
+	// Every discussion is returned if it is not set
+	AnsweredOnly *bool `json:"answeredOnly,omitempty"`
+
+	// IncludeReactions adds the total number of reactions to every discussion as a `reactions` column, and the number of reactions with every emoji as `reactions_<emoji>` columns (ex: reactions_thumbs_up)
+	IncludeReactions bool `json:"includeReactions"`
+}
+
+// DiscussionsOptionsWithRepo adds the Owner and Repository options to a ListDiscussionsOptions type
+func DiscussionsOptionsWithRepo(opt ListDiscussionsOptions, owner string, repo string) ListDiscussionsOptions {
+	return ListDiscussionsOptions{
+		Owner:            owner,
+		Repository:       repo,
+		Query:            opt.Query,
+		Category:         opt.Category,
+		AnsweredOnly:     opt.AnsweredOnly,
+		IncludeReactions: opt.IncludeReactions,
+	}
+}
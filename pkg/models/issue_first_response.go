@@ -0,0 +1,30 @@
+package models
+
+// ListIssueFirstResponseOptions are the available options when measuring the time until the first response to issues
+type ListIssueFirstResponseOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Query is an additional search query (ex: "label:type/bug")
+	Query *string `json:"query,omitempty"`
+
+	// Maintainers is a comma separated list of logins. If it is set, only comments by these users are counted as a response
+	Maintainers string `json:"maintainers,omitempty"`
+
+	// Team is the slug of a team in the Owner organization (ex: grafana-frontend). If it is set, only comments by members of the team are counted as a response
+	Team string `json:"team,omitempty"`
+}
+
+// IssueFirstResponseOptionsWithRepo adds the Owner and Repository values to a ListIssueFirstResponseOptions. This is a convience function because this is a common operation
+func IssueFirstResponseOptionsWithRepo(opt ListIssueFirstResponseOptions, owner string, repo string) ListIssueFirstResponseOptions {
+	return ListIssueFirstResponseOptions{
+		Owner:       owner,
+		Repository:  repo,
+		Query:       opt.Query,
+		Maintainers: opt.Maintainers,
+		Team:        opt.Team,
+	}
+}
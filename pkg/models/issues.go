@@ -16,6 +16,9 @@ func (d IssueTimeField) String() string {
 	return [...]string{"created", "closed"}[d]
 }
 
+// DefaultBodyMaxLength is the number of characters after which the description of an issue or pull request is cut off, when it is not set in the query
+const DefaultBodyMaxLength = 1000
+
 // ListIssuesOptions provides options when retrieving issues
 type ListIssuesOptions struct {
 	Repository string                 `json:"repository"`
@@ -23,15 +26,161 @@ type ListIssuesOptions struct {
 	Filters    *githubv4.IssueFilters `json:"filters"`
 	Query      *string                `json:"query,omitempty"`
 	TimeField  IssueTimeField         `json:"timeField"`
+
+	// Org lists the issues in every repository of an organization. It is only used when the Repository is not set
+	Org string `json:"org,omitempty"`
+
+	// Labels only returns the issues that have these labels (ex: ["type/bug", "regression"])
+	Labels []string `json:"labels,omitempty"`
+
+	// LabelsMatch defines whether the issues need to have all (the default) or any of the Labels
+	LabelsMatch LabelsMatch `json:"labelsMatch,omitempty"`
+
+	// Viewer limits the search to the issues that are related to the authenticated user, so that the login does not have to be part of the query
+	Viewer ViewerRelation `json:"viewer,omitempty"`
+
+	// ExcludeBots removes the issues opened by bot accounts (like dependabot or renovate) from the results
+	ExcludeBots bool `json:"excludeBots"`
+
+	// IncludePullRequests also returns the pull requests that match the search, with an `is_pull_request` column to distinguish them from issues
+	IncludePullRequests bool `json:"includePullRequests"`
+
+	// IncludeBody adds the description of every issue as a `body` column. The description is not part of the query if it is not set, because it can be large
+	IncludeBody bool `json:"includeBody"`
+
+	// BodyMaxLength is the number of characters after which a description is cut off. DefaultBodyMaxLength is used if it is not set
+	BodyMaxLength int64 `json:"bodyMaxLength"`
+
+	// IncludeReopenedCount adds the number of times every issue was reopened as a `reopened_count` column. It is not part of the query if it is not set, because GitHub has to count the timeline events of every issue
+	IncludeReopenedCount bool `json:"includeReopenedCount"`
+
+	// NormalizeCompany strips the leading '@' and whitespace from the author's company. The raw value is added as a separate column
+	NormalizeCompany bool `json:"normalizeCompany"`
+
+	// Bucket groups the issues into day, week, or month buckets and returns the number of issues per bucket instead of one row per issue
+	Bucket BucketInterval `json:"bucket,omitempty"`
+
+	// BucketField defines which time field (created or closed) the issues are bucketed by. If Bucket is set, the issues are also searched by this field instead of TimeField
+	BucketField IssueTimeField `json:"bucketField"`
+
+	// Timezone is the IANA name of the time zone (ex: Europe/Berlin) that the day, week, and month buckets start at midnight in. The buckets are aligned in UTC if it is empty.
+	// It only changes the buckets; the times of the issues are always in UTC
+	Timezone string `json:"timezone,omitempty"`
+
+	// SplitByState returns an "opened" frame with the time every issue was created, and a "closed" frame with the time every closed issue was closed, instead of a single frame.
+	// The issues that were created in the time range and the issues that were closed in the time range are separate searches, so TimeField is not used. If Bucket is set, both frames contain the number of issues per bucket
+	SplitByState bool `json:"splitByState"`
+
+	// Debug logs the cursor of every page that is requested, and adds the number of pages to the frame's stats
+	Debug bool `json:"debug"`
+
+	// AutoSplitRange splits the time range into smaller time ranges when a search matches more issues than GitHub's search API returns
+	AutoSplitRange bool `json:"autoSplitRange"`
+
+	// Cursor is the end cursor of a previous query (returned in the frame's custom meta data). If it is set, the pagination continues after the last page of that query instead of starting at the first page.
+	// It is not used if AutoSplitRange or SplitByState is set
+	Cursor string `json:"cursor,omitempty"`
 }
 
 // IssueOptionsWithRepo adds the Owner and Repository values to a ListIssuesOptions. This is a convience function because this is a common operation
 func IssueOptionsWithRepo(opt ListIssuesOptions, owner string, repo string) ListIssuesOptions {
 	return ListIssuesOptions{
+		Owner:                owner,
+		Repository:           repo,
+		Filters:              opt.Filters,
+		Query:                opt.Query,
+		TimeField:            opt.TimeField,
+		Org:                  opt.Org,
+		Labels:               opt.Labels,
+		LabelsMatch:          opt.LabelsMatch,
+		Viewer:               opt.Viewer,
+		ExcludeBots:          opt.ExcludeBots,
+		IncludePullRequests:  opt.IncludePullRequests,
+		IncludeBody:          opt.IncludeBody,
+		BodyMaxLength:        opt.BodyMaxLength,
+		IncludeReopenedCount: opt.IncludeReopenedCount,
+		NormalizeCompany:     opt.NormalizeCompany,
+		Bucket:               opt.Bucket,
+		BucketField:          opt.BucketField,
+		Timezone:             opt.Timezone,
+		SplitByState:         opt.SplitByState,
+		Debug:                opt.Debug,
+		AutoSplitRange:       opt.AutoSplitRange,
+		Cursor:               opt.Cursor,
+	}
+}
+
+// DefaultStaleIssueDays is the number of days without an update after which an issue is considered stale, when it is not set in the query
+const DefaultStaleIssueDays = 90
+
+// ListStaleIssuesOptions provides options when retrieving the open issues that have not been updated recently
+type ListStaleIssuesOptions struct {
+	Repository string  `json:"repository"`
+	Owner      string  `json:"owner"`
+	Query      *string `json:"query,omitempty"`
+
+	// Days is the number of days without an update after which an issue is considered stale. DefaultStaleIssueDays is used if it is not set
+	Days int64 `json:"days"`
+
+	// ExcludeBots removes the issues opened by bot accounts (like dependabot or renovate) from the results
+	ExcludeBots bool `json:"excludeBots"`
+}
+
+// StaleIssueOptionsWithRepo adds the Owner and Repository values to a ListStaleIssuesOptions. This is a convience function because this is a common operation
+func StaleIssueOptionsWithRepo(opt ListStaleIssuesOptions, owner string, repo string) ListStaleIssuesOptions {
+	return ListStaleIssuesOptions{
+		Owner:       owner,
+		Repository:  repo,
+		Query:       opt.Query,
+		Days:        opt.Days,
+		ExcludeBots: opt.ExcludeBots,
+	}
+}
+
+// ListIssueAssigneesOptions provides options when counting the open issues per assignee
+type ListIssueAssigneesOptions struct {
+	Repository string  `json:"repository"`
+	Owner      string  `json:"owner"`
+	Query      *string `json:"query,omitempty"`
+}
+
+// IssueAssigneesOptionsWithRepo adds the Owner and Repository values to a ListIssueAssigneesOptions. This is a convience function because this is a common operation
+func IssueAssigneesOptionsWithRepo(opt ListIssueAssigneesOptions, owner string, repo string) ListIssueAssigneesOptions {
+	return ListIssueAssigneesOptions{
 		Owner:      owner,
 		Repository: repo,
-		Filters:    opt.Filters,
 		Query:      opt.Query,
-		TimeField:  opt.TimeField,
+	}
+}
+
+// DefaultIncidentLabel is the label that marks the issues that are incidents, when it is not set in the query
+const DefaultIncidentLabel = "incident"
+
+// ListMeanTimeToRestoreOptions are the available options when computing the mean time to restore from the incidents of a repository
+type ListMeanTimeToRestoreOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Label is the label of the issues that are incidents. DefaultIncidentLabel is used if it is empty
+	Label string `json:"label,omitempty"`
+
+	// Bucket computes the mean time to restore of the incidents that were closed in every day, week, or month bucket instead of a single mean for the time range
+	Bucket BucketInterval `json:"bucket,omitempty"`
+
+	// Timezone is the IANA name of the time zone (ex: Europe/Berlin) that the buckets are aligned in. UTC is used if it is empty
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// MeanTimeToRestoreOptionsWithRepo adds the Owner and Repository options to a ListMeanTimeToRestoreOptions type. This is just for convenience
+func MeanTimeToRestoreOptionsWithRepo(opt ListMeanTimeToRestoreOptions, owner string, repo string) ListMeanTimeToRestoreOptions {
+	return ListMeanTimeToRestoreOptions{
+		Owner:      owner,
+		Repository: repo,
+		Label:      opt.Label,
+		Bucket:     opt.Bucket,
+		Timezone:   opt.Timezone,
 	}
 }
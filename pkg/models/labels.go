@@ -10,4 +10,18 @@ type ListLabelsOptions struct {
 
 	// Query searches labels by name and description
 	Query string `json:"query"`
+
+	// IssueCounts returns the number of open issues for every label, with one column per label that uses the label's color.
+	// This frame can be used directly in panels with a legend, like pie charts or bar gauges
+	IssueCounts bool `json:"issueCounts"`
 }
+
+// LabelsMatch defines whether the issues or pull requests need to have every label in the Labels option, or only one of them
+type LabelsMatch string
+
+const (
+	// LabelsMatchAll only returns the issues or pull requests that have every label. It is used when LabelsMatch is not set
+	LabelsMatchAll LabelsMatch = "all"
+	// LabelsMatchAny returns the issues or pull requests that have at least one of the labels
+	LabelsMatchAny LabelsMatch = "any"
+)
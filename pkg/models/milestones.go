@@ -10,4 +10,7 @@ type ListMilestonesOptions struct {
 
 	// Query searches milestones by name and description
 	Query string `json:"query"`
+
+	// Annotations returns the milestones as annotations, with the due date as the time and the title as the text. Milestones without a due date are skipped
+	Annotations bool `json:"annotations"`
 }
@@ -0,0 +1,18 @@
+package models
+
+// ListPinnedIssuesOptions are the available options when listing the pinned issues of a repository
+type ListPinnedIssuesOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+}
+
+// PinnedIssuesOptionsWithRepo adds the Owner and Repository options to a ListPinnedIssuesOptions type. This is just for convenience
+func PinnedIssuesOptionsWithRepo(opt ListPinnedIssuesOptions, owner string, repo string) ListPinnedIssuesOptions {
+	return ListPinnedIssuesOptions{
+		Owner:      owner,
+		Repository: repo,
+	}
+}
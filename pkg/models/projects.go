@@ -0,0 +1,92 @@
+package models
+
+// ListProjectsOptions are the available options when listing the GitHub Projects (v2) of an organization
+type ListProjectsOptions struct {
+	// Owner is the login of the organization that owns the projects (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Query only returns the projects whose title matches it. Every project is returned if it is empty
+	Query string `json:"query,omitempty"`
+}
+
+// ProjectsOptionsWithOwner adds the Owner to a ListProjectsOptions. This is just for convenience
+func ProjectsOptionsWithOwner(opt ListProjectsOptions, owner string) ListProjectsOptions {
+	return ListProjectsOptions{
+		Owner: owner,
+		Query: opt.Query,
+	}
+}
+
+// ListProjectItemsOptions are the available options when listing the items in a GitHub Project (v2)
+type ListProjectItemsOptions struct {
+	// Owner is the login of the organization that owns the project (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Number is the number of the project in the organization. It can be found in the project URL
+	Number int64 `json:"number"`
+
+	// Fields are the names of the custom project fields (like "Story Points" or "Target Date") that are added as columns
+	Fields []string `json:"fields,omitempty"`
+}
+
+// ProjectItemsOptionsWithOwner adds the Owner to a ListProjectItemsOptions. This is just for convenience
+func ProjectItemsOptionsWithOwner(opt ListProjectItemsOptions, owner string) ListProjectItemsOptions {
+	return ListProjectItemsOptions{
+		Owner:  owner,
+		Number: opt.Number,
+		Fields: opt.Fields,
+	}
+}
+
+// DefaultProjectStatusField is the name of the single select field that GitHub adds to every project to track the status of the items
+const DefaultProjectStatusField = "Status"
+
+// ListProjectIssuesOptions are the available options when listing the issues in a GitHub Project (v2) that have a given status
+type ListProjectIssuesOptions struct {
+	// Owner is the login of the organization that owns the project (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Number is the number of the project in the organization. It can be found in the project URL
+	Number int64 `json:"number"`
+
+	// StatusField is the name of the single select field that has the status of the items. DefaultProjectStatusField is used if it is empty
+	StatusField string `json:"statusField,omitempty"`
+
+	// Status is the value of the status field (ex: "In Progress"). It is not case-sensitive
+	Status string `json:"status"`
+
+	// IncludePullRequests also returns the pull requests in the project, with an `is_pull_request` column to distinguish them from issues
+	IncludePullRequests bool `json:"includePullRequests"`
+}
+
+// ProjectIssuesOptionsWithOwner adds the Owner to a ListProjectIssuesOptions. This is just for convenience
+func ProjectIssuesOptionsWithOwner(opt ListProjectIssuesOptions, owner string) ListProjectIssuesOptions {
+	return ListProjectIssuesOptions{
+		Owner:               owner,
+		Number:              opt.Number,
+		StatusField:         opt.StatusField,
+		Status:              opt.Status,
+		IncludePullRequests: opt.IncludePullRequests,
+	}
+}
+
+// ListProjectTimeInStatusOptions are the available options when listing how long the items in a GitHub Project (v2) have had their current status
+type ListProjectTimeInStatusOptions struct {
+	// Owner is the login of the organization that owns the project (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Number is the number of the project in the organization. It can be found in the project URL
+	Number int64 `json:"number"`
+
+	// StatusField is the name of the single select field that has the status of the items. DefaultProjectStatusField is used if it is empty
+	StatusField string `json:"statusField,omitempty"`
+}
+
+// ProjectTimeInStatusOptionsWithOwner adds the Owner to a ListProjectTimeInStatusOptions. This is just for convenience
+func ProjectTimeInStatusOptionsWithOwner(opt ListProjectTimeInStatusOptions, owner string) ListProjectTimeInStatusOptions {
+	return ListProjectTimeInStatusOptions{
+		Owner:       owner,
+		Number:      opt.Number,
+		StatusField: opt.StatusField,
+	}
+}
@@ -0,0 +1,22 @@
+package models
+
+// ListPullRequestFilesOptions are the available options when listing the files changed in a pull request
+type ListPullRequestFilesOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Number is the number of the pull request
+	Number int64 `json:"number"`
+}
+
+// PullRequestFilesOptionsWithRepo adds the Owner and Repository options to a ListPullRequestFilesOptions type
+func PullRequestFilesOptionsWithRepo(opt ListPullRequestFilesOptions, owner string, repo string) ListPullRequestFilesOptions {
+	return ListPullRequestFilesOptions{
+		Owner:      owner,
+		Repository: repo,
+		Number:     opt.Number,
+	}
+}
@@ -18,6 +18,20 @@ func (d PullRequestTimeField) String() string {
 	return [...]string{"closed", "created", "merged"}[d]
 }
 
+// PullRequestState filters pull requests by where they are in their lifecycle
+type PullRequestState string
+
+const (
+	// PullRequestStateAll returns pull requests in every state. It is used when State is not set
+	PullRequestStateAll PullRequestState = "all"
+	// PullRequestStateOpen only returns the pull requests that are open
+	PullRequestStateOpen PullRequestState = "open"
+	// PullRequestStateClosed only returns the pull requests that were closed without being merged
+	PullRequestStateClosed PullRequestState = "closed"
+	// PullRequestStateMerged only returns the pull requests that were merged
+	PullRequestStateMerged PullRequestState = "merged"
+)
+
 // ListPullRequestsOptions are the available options when listing pull requests in a time range
 type ListPullRequestsOptions struct {
 	// Repository is the name of the repository being queried (ex: grafana)
@@ -30,14 +44,132 @@ type ListPullRequestsOptions struct {
 	TimeField PullRequestTimeField `json:"timeField"`
 
 	Query *string `json:"query,omitempty"`
+
+	// Labels only returns the pull requests that have these labels (ex: ["type/bug", "regression"])
+	Labels []string `json:"labels,omitempty"`
+
+	// LabelsMatch defines whether the pull requests need to have all (the default) or any of the Labels
+	LabelsMatch LabelsMatch `json:"labelsMatch,omitempty"`
+
+	// State only returns the pull requests that are open, closed without being merged, or merged. Every pull request is returned if it is empty or "all"
+	State PullRequestState `json:"state,omitempty"`
+
+	// BaseBranch only returns the pull requests that target this branch (ex: main). If it is empty, the pull requests that target any branch are returned, not only the ones that target the default branch
+	BaseBranch string `json:"baseBranch,omitempty"`
+
+	// Viewer limits the search to the pull requests that are related to the authenticated user, so that the login does not have to be part of the query
+	Viewer ViewerRelation `json:"viewer,omitempty"`
+
+	// ExcludeBots removes the pull requests opened by bot accounts (like dependabot or renovate) from the results
+	ExcludeBots bool `json:"excludeBots"`
+
+	// Fields is the list of columns to return. Parts of the GraphQL query that are only needed for other columns are skipped. Every column is returned if it is empty
+	Fields []string `json:"fields,omitempty"`
+
+	// IncludeBody adds the description of every pull request as a `body` column. The description is not part of the query if it is not set, because it can be large
+	IncludeBody bool `json:"includeBody"`
+
+	// BodyMaxLength is the number of characters after which a description is cut off. DefaultBodyMaxLength is used if it is not set
+	BodyMaxLength int64 `json:"bodyMaxLength"`
+
+	// IncludeClosingIssues adds the numbers of the issues that every pull request closes when it is merged as a `closes_issues` column
+	IncludeClosingIssues bool `json:"includeClosingIssues"`
+
+	// IncludeReactions adds the total number of reactions to every pull request as a `reactions` column, and the number of reactions with every emoji as `reactions_<emoji>` columns (ex: reactions_thumbs_up)
+	IncludeReactions bool `json:"includeReactions"`
+
+	// IncludeReopenedCount adds the number of times every pull request was reopened as a `reopened_count` column
+	IncludeReopenedCount bool `json:"includeReopenedCount"`
+
+	// IncludeFirstReview adds the number of seconds between the creation of every pull request and its first review as a `time_to_first_review_seconds` column
+	IncludeFirstReview bool `json:"includeFirstReview"`
+
+	// Debug logs the cursor of every page that is requested, and adds the number of pages to the frame's stats
+	Debug bool `json:"debug"`
+
+	// Cursor is the end cursor of a previous query (returned in the frame's custom meta data). If it is set, the pagination continues after the last page of that query instead of starting at the first page
+	Cursor string `json:"cursor,omitempty"`
 }
 
 // PullRequestOptionsWithRepo adds the Owner and Repository options to a ListPullRequestsOptions type
 func PullRequestOptionsWithRepo(opt ListPullRequestsOptions, owner string, repo string) ListPullRequestsOptions {
 	return ListPullRequestsOptions{
+		Owner:                owner,
+		Repository:           repo,
+		Query:                opt.Query,
+		TimeField:            opt.TimeField,
+		Labels:               opt.Labels,
+		LabelsMatch:          opt.LabelsMatch,
+		State:                opt.State,
+		BaseBranch:           opt.BaseBranch,
+		Viewer:               opt.Viewer,
+		ExcludeBots:          opt.ExcludeBots,
+		Fields:               opt.Fields,
+		IncludeBody:          opt.IncludeBody,
+		BodyMaxLength:        opt.BodyMaxLength,
+		IncludeClosingIssues: opt.IncludeClosingIssues,
+		IncludeReactions:     opt.IncludeReactions,
+		IncludeReopenedCount: opt.IncludeReopenedCount,
+		IncludeFirstReview:   opt.IncludeFirstReview,
+		Debug:                opt.Debug,
+		Cursor:               opt.Cursor,
+	}
+}
+
+// ListReviewRequestsOptions are the available options when listing the open pull requests that are waiting for a review of a user
+type ListReviewRequestsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana). The pull requests of every repository of the owner are returned if it is empty
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana). The pull requests of every repository are returned if both Owner and Repository are empty
+	Owner string `json:"owner"`
+
+	// Login is the user whose review is requested. The authenticated user is used if it is empty
+	Login string `json:"login"`
+
+	// Query is an optional search query that is added to the search, like "label:security"
+	Query *string `json:"query,omitempty"`
+}
+
+// ReviewRequestsOptionsWithRepo adds the Owner and Repository options to a ListReviewRequestsOptions type. This is just for convenience
+func ReviewRequestsOptionsWithRepo(opt ListReviewRequestsOptions, owner string, repo string) ListReviewRequestsOptions {
+	return ListReviewRequestsOptions{
 		Owner:      owner,
 		Repository: repo,
+		Login:      opt.Login,
 		Query:      opt.Query,
-		TimeField:  opt.TimeField,
+	}
+}
+
+// ListLeadTimeForChangesOptions are the available options when computing the lead time for changes from the pull requests of a repository
+type ListLeadTimeForChangesOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// BaseBranch only counts the pull requests that were merged into this branch (ex: main). The pull requests merged into any branch are counted if it is empty
+	BaseBranch string `json:"baseBranch,omitempty"`
+
+	// ExcludeBots removes the pull requests opened by bot accounts (like dependabot or renovate) from the lead time
+	ExcludeBots bool `json:"excludeBots"`
+
+	// Bucket computes the lead time of the pull requests that were merged in every day, week, or month bucket instead of a single lead time for the time range
+	Bucket BucketInterval `json:"bucket,omitempty"`
+
+	// Timezone is the IANA name of the time zone (ex: Europe/Berlin) that the buckets are aligned in. UTC is used if it is empty
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// LeadTimeForChangesOptionsWithRepo adds the Owner and Repository options to a ListLeadTimeForChangesOptions type. This is just for convenience
+func LeadTimeForChangesOptionsWithRepo(opt ListLeadTimeForChangesOptions, owner string, repo string) ListLeadTimeForChangesOptions {
+	return ListLeadTimeForChangesOptions{
+		Owner:       owner,
+		Repository:  repo,
+		BaseBranch:  opt.BaseBranch,
+		ExcludeBots: opt.ExcludeBots,
+		Bucket:      opt.Bucket,
+		Timezone:    opt.Timezone,
 	}
 }
@@ -3,28 +3,90 @@ package models
 const (
 	// QueryTypeCommits is sent by the frontend when querying commits in a GitHub repository
 	QueryTypeCommits = "Commits"
+	// QueryTypeCommitComments is used when querying the comments on commits in a GitHub repository
+	QueryTypeCommitComments = "Commit_Comments"
+	// QueryTypeCommitAuthors is used when querying the number of commits per author in a GitHub repository
+	QueryTypeCommitAuthors = "Commit_Authors"
 	// QueryTypeIssues is used when querying issues in a GitHub repository
 	QueryTypeIssues = "Issues"
+	// QueryTypePinnedIssues is used when querying the issues that are pinned in a GitHub repository
+	QueryTypePinnedIssues = "Pinned_Issues"
+	// QueryTypeStaleIssues is used when querying open issues that have not been updated in a while in a GitHub repository
+	QueryTypeStaleIssues = "Stale_Issues"
+	// QueryTypeIssueBurndown is used when querying the number of issues opened and closed over time in a GitHub repository
+	QueryTypeIssueBurndown = "Issue_Burndown"
+	// QueryTypeIssueAssignees is used when querying the number of open issues per assignee in a GitHub repository
+	QueryTypeIssueAssignees = "Issue_Assignees"
+	// QueryTypeIssueFirstResponse is used when querying the time until the first response to issues in a GitHub repository
+	QueryTypeIssueFirstResponse = "Issue_First_Response"
+	// QueryTypeMeanTimeToRestore is used when querying for the mean time to close the incident issues of a GitHub repository
+	QueryTypeMeanTimeToRestore = "Mean_Time_To_Restore"
+	// QueryTypeLeadTimeForChanges is used when querying for the mean time from opening to merging the pull requests of a GitHub repository
+	QueryTypeLeadTimeForChanges = "Lead_Time_For_Changes"
 	// QueryTypeContributors is used when querying contributors in a GitHub repository
 	QueryTypeContributors = "Contributors"
+	// QueryTypeTopContributors is used when ranking the contributors of a GitHub repository by the number of issues, pull requests, and commits in a time range
+	QueryTypeTopContributors = "Top_Contributors"
+	// QueryTypeContributionCalendar is used when querying the number of contributions per day of a GitHub user
+	QueryTypeContributionCalendar = "Contribution_Calendar"
 	// QueryTypeTags is used when querying tags in a GitHub repository
 	QueryTypeTags = "Tags"
 	// QueryTypeReleases is used when querying releases in a GitHub repository
 	QueryTypeReleases = "Releases"
 	// QueryTypePullRequests is used when querying pull requests in a GitHub repository
 	QueryTypePullRequests = "Pull_Requests"
+	// QueryTypePullRequestFiles is used when querying the files changed in a pull request
+	QueryTypePullRequestFiles = "Pull_Request_Files"
+	// QueryTypeReviewRequests is used when querying the open pull requests that are waiting for the review of a GitHub user
+	QueryTypeReviewRequests = "Review_Requests"
+	// QueryTypeDiscussions is used when querying discussions in a GitHub repository
+	QueryTypeDiscussions = "Discussions"
+	// QueryTypeActivity is used when querying the most recently updated issues and pull requests of a GitHub repository or organization
+	QueryTypeActivity = "Activity"
 	// QueryTypeLabels is used when querying labels in a GitHub repository
 	QueryTypeLabels = "Labels"
 	// QueryTypeRepositories is used when querying for a GitHub repository
 	QueryTypeRepositories = "Repositories"
+	// QueryTypeRepoSummary is used when querying the open issue, open pull request, star, and fork counts of a list of GitHub repositories
+	QueryTypeRepoSummary = "Repo_Summary"
+	// QueryTypeAuditLog is used when querying the audit log events of a GitHub organization
+	QueryTypeAuditLog = "Audit_Log"
 	// QueryTypeOrganizations is used when querying for GitHub organizations
 	QueryTypeOrganizations = "Organizations"
+	// QueryTypeRateLimit is used when querying the remaining rate limits of every GitHub API resource
+	QueryTypeRateLimit = "Rate_Limit"
 	// QueryTypeGraphQL is used when sending an ad-hoc graphql query
 	QueryTypeGraphQL = "GraphQL"
 	// QueryTypePackages is used when querying for NPM / Docker / etc packages
 	QueryTypePackages = "Packages"
 	// QueryTypeMilestones is used when querying for milestones in a repository
 	QueryTypeMilestones = "Milestones"
+	// QueryTypeProjects is used when querying for the GitHub Projects (v2) of an organization
+	QueryTypeProjects = "Projects"
+	// QueryTypeProjectItems is used when querying for the items in a GitHub Project (v2)
+	QueryTypeProjectItems = "Project_Items"
+	// QueryTypeProjectIssues is used when querying for the issues in a GitHub Project (v2) that have a given status
+	QueryTypeProjectIssues = "Project_Issues"
+	// QueryTypeProjectTimeInStatus is used when querying for how long the items in a GitHub Project (v2) have had their current status
+	QueryTypeProjectTimeInStatus = "Project_Time_In_Status"
+	// QueryTypeSecretScanningAlerts is used when querying for the secret scanning alerts in a repository
+	QueryTypeSecretScanningAlerts = "Secret_Scanning_Alerts"
+	// QueryTypeDeployKeys is used when querying for the deploy keys of a repository
+	QueryTypeDeployKeys = "Deploy_Keys"
+	// QueryTypeWorkflows is used when querying for the GitHub Actions workflows in a repository
+	QueryTypeWorkflows = "Workflows"
+	// QueryTypeWebhooks is used when querying for the webhooks of a repository or organization, along with the response to their last delivery
+	QueryTypeWebhooks = "Webhooks"
+	// QueryTypeRulesets is used when querying for the rulesets of a repository or organization
+	QueryTypeRulesets = "Rulesets"
+	// QueryTypeDeployments is used when querying for the deployments in a repository
+	QueryTypeDeployments = "Deployments"
+	// QueryTypeDeploymentFrequency is used when querying for the number of deployments to every environment of a repository
+	QueryTypeDeploymentFrequency = "Deployment_Frequency"
+	// QueryTypeChangeFailureRate is used when querying for the share of the deployments of a repository that failed
+	QueryTypeChangeFailureRate = "Change_Failure_Rate"
+	// QueryTypeDeploymentStatuses is used when querying for the status history of a single deployment
+	QueryTypeDeploymentStatuses = "Deployment_Statuses"
 )
 
 // Query refers to the structure of a query built using the QueryEditor.
@@ -34,6 +96,10 @@ const (
 type Query struct {
 	Repository string `json:"repository"`
 	Owner      string `json:"owner"`
+
+	// RepositoryID is the GraphQL node ID of the repository. Unlike the owner and name, it does not change when the repository is renamed or transferred.
+	// If it is set, the Owner and Repository are replaced with the current owner and name of the repository before the query is handled
+	RepositoryID string `json:"repositoryId,omitempty"`
 }
 
 // PullRequestsQuery is used when querying for GitHub Pull Requests
@@ -42,12 +108,48 @@ type PullRequestsQuery struct {
 	Options ListPullRequestsOptions `json:"options"`
 }
 
+// PullRequestFilesQuery is used when querying for the files changed in a GitHub Pull Request
+type PullRequestFilesQuery struct {
+	Query
+	Options ListPullRequestFilesOptions `json:"options"`
+}
+
+// ReviewRequestsQuery is used when querying for the open GitHub Pull Requests that are waiting for the review of a user
+type ReviewRequestsQuery struct {
+	Query
+	Options ListReviewRequestsOptions `json:"options"`
+}
+
+// DiscussionsQuery is used when querying for GitHub discussions
+type DiscussionsQuery struct {
+	Query
+	Options ListDiscussionsOptions `json:"options"`
+}
+
+// ActivityQuery is used when querying for the most recently updated GitHub issues and pull requests
+type ActivityQuery struct {
+	Query
+	Options ListActivityOptions `json:"options"`
+}
+
 // CommitsQuery is used when querying for GitHub commits
 type CommitsQuery struct {
 	Query
 	Options ListCommitsOptions `json:"options"`
 }
 
+// CommitCommentsQuery is used when querying for the comments on GitHub commits
+type CommitCommentsQuery struct {
+	Query
+	Options ListCommitCommentsOptions `json:"options"`
+}
+
+// CommitAuthorsQuery is used when querying for the number of commits per author
+type CommitAuthorsQuery struct {
+	Query
+	Options ListCommitAuthorsOptions `json:"options"`
+}
+
 // TagsQuery is used when querying for GitHub tags
 type TagsQuery struct {
 	Query
@@ -72,17 +174,88 @@ type ContributorsQuery struct {
 	Options ListContributorsOptions `json:"options"`
 }
 
+// TopContributorsQuery is used when ranking the contributors of a GitHub repository by their activity
+type TopContributorsQuery struct {
+	Query
+	Options ListTopContributorsOptions `json:"options"`
+}
+
+// ContributionCalendarQuery is used when querying for the contribution calendar of a GitHub user
+type ContributionCalendarQuery struct {
+	Query
+	Options ListContributionCalendarOptions `json:"options"`
+}
+
 // RepositoriesQuery is used when querying for GitHub repositories
 type RepositoriesQuery struct {
 	Query
 }
 
+// AuditLogQuery is used when querying for the audit log events of a GitHub organization
+type AuditLogQuery struct {
+	Query
+	Options ListAuditLogOptions `json:"options"`
+}
+
+// RateLimitQuery is used when querying for the rate limits of the GitHub API
+type RateLimitQuery struct {
+	Query
+}
+
+// RepoSummaryQuery is used when querying for a summary of a list of GitHub repositories
+type RepoSummaryQuery struct {
+	Query
+	Options ListRepoSummaryOptions `json:"options"`
+}
+
 // IssuesQuery is used when querying for GitHub issues
 type IssuesQuery struct {
 	Query
 	Options ListIssuesOptions `json:"options"`
 }
 
+// PinnedIssuesQuery is used when querying for the pinned issues of a GitHub repository
+type PinnedIssuesQuery struct {
+	Query
+	Options ListPinnedIssuesOptions `json:"options"`
+}
+
+// StaleIssuesQuery is used when querying for stale GitHub issues
+type StaleIssuesQuery struct {
+	Query
+	Options ListStaleIssuesOptions `json:"options"`
+}
+
+// IssueBurndownQuery is used when querying for the number of GitHub issues opened and closed over time
+type IssueBurndownQuery struct {
+	Query
+	Options ListIssuesOptions `json:"options"`
+}
+
+// IssueAssigneesQuery is used when querying for the number of open GitHub issues per assignee
+type IssueAssigneesQuery struct {
+	Query
+	Options ListIssueAssigneesOptions `json:"options"`
+}
+
+// IssueFirstResponseQuery is used when querying for the time until the first response to GitHub issues
+type IssueFirstResponseQuery struct {
+	Query
+	Options ListIssueFirstResponseOptions `json:"options"`
+}
+
+// MeanTimeToRestoreQuery is used when querying for the mean time to close the GitHub issues that are incidents
+type MeanTimeToRestoreQuery struct {
+	Query
+	Options ListMeanTimeToRestoreOptions `json:"options"`
+}
+
+// LeadTimeForChangesQuery is used when querying for the mean time from opening to merging GitHub pull requests
+type LeadTimeForChangesQuery struct {
+	Query
+	Options ListLeadTimeForChangesOptions `json:"options"`
+}
+
 // PackagesQuery is used when querying for GitHub packages, including NPM, Maven, PyPi, Rubygems, and Docker
 type PackagesQuery struct {
 	Query
@@ -94,3 +267,81 @@ type MilestonesQuery struct {
 	Query
 	Options ListMilestonesOptions `json:"options"`
 }
+
+// ProjectsQuery is used when querying for the GitHub Projects (v2) of an organization
+type ProjectsQuery struct {
+	Query
+	Options ListProjectsOptions `json:"options"`
+}
+
+// ProjectItemsQuery is used when querying for the items in a GitHub Project (v2)
+type ProjectItemsQuery struct {
+	Query
+	Options ListProjectItemsOptions `json:"options"`
+}
+
+// ProjectIssuesQuery is used when querying for the issues in a GitHub Project (v2) that have a given status
+type ProjectIssuesQuery struct {
+	Query
+	Options ListProjectIssuesOptions `json:"options"`
+}
+
+// ProjectTimeInStatusQuery is used when querying for how long the items in a GitHub Project (v2) have had their current status
+type ProjectTimeInStatusQuery struct {
+	Query
+	Options ListProjectTimeInStatusOptions `json:"options"`
+}
+
+// SecretScanningAlertsQuery is used when querying for GitHub secret scanning alerts
+type SecretScanningAlertsQuery struct {
+	Query
+	Options ListSecretScanningAlertsOptions `json:"options"`
+}
+
+// DeployKeysQuery is used when querying for the deploy keys of a GitHub repository
+type DeployKeysQuery struct {
+	Query
+	Options ListDeployKeysOptions `json:"options"`
+}
+
+// WorkflowsQuery is used when querying for the GitHub Actions workflows of a repository
+type WorkflowsQuery struct {
+	Query
+	Options ListWorkflowsOptions `json:"options"`
+}
+
+// WebhooksQuery is used when querying for the webhooks of a GitHub repository or organization
+type WebhooksQuery struct {
+	Query
+	Options ListWebhooksOptions `json:"options"`
+}
+
+// RulesetsQuery is used when querying for the rulesets of a GitHub repository or organization
+type RulesetsQuery struct {
+	Query
+	Options ListRulesetsOptions `json:"options"`
+}
+
+// DeploymentsQuery is used when querying for GitHub deployments
+type DeploymentsQuery struct {
+	Query
+	Options ListDeploymentsOptions `json:"options"`
+}
+
+// DeploymentFrequencyQuery is used when querying for the number of GitHub deployments to every environment
+type DeploymentFrequencyQuery struct {
+	Query
+	Options ListDeploymentFrequencyOptions `json:"options"`
+}
+
+// ChangeFailureRateQuery is used when querying for the share of GitHub deployments that failed
+type ChangeFailureRateQuery struct {
+	Query
+	Options ListChangeFailureRateOptions `json:"options"`
+}
+
+// DeploymentStatusesQuery is used when querying for the statuses of a GitHub deployment
+type DeploymentStatusesQuery struct {
+	Query
+	Options ListDeploymentStatusesOptions `json:"options"`
+}
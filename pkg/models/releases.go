@@ -7,4 +7,19 @@ type ListReleasesOptions struct {
 
 	// Owner is the owner of the repository (ex: grafana)
 	Owner string `json:"owner"`
+
+	// ExcludeDrafts removes the draft releases from the results
+	ExcludeDrafts bool `json:"excludeDrafts"`
+
+	// ExcludePrereleases removes the releases that are marked as a pre-release from the results
+	ExcludePrereleases bool `json:"excludePrereleases"`
+
+	// Bucket groups the releases by their publish date into day, week, or month buckets and returns the number of releases per bucket instead of one row per release
+	Bucket BucketInterval `json:"bucket,omitempty"`
+
+	// Timezone is the IANA name of the time zone (ex: Europe/Berlin) that the buckets start at midnight in. UTC is used if it is empty
+	Timezone string `json:"timezone,omitempty"`
+
+	// IncludeContributors adds the logins of the users that are mentioned in the notes of every release as a `contributors` column, and their number as a `contributors_count` column
+	IncludeContributors bool `json:"includeContributors"`
 }
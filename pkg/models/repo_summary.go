@@ -0,0 +1,17 @@
+package models
+
+// ListRepoSummaryOptions are the available options when summarizing a list of repositories
+type ListRepoSummaryOptions struct {
+	// Owner is the owner of the repositories that are listed without an owner (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Repositories is a comma separated list of repositories (ex: "grafana, loki, prometheus/prometheus").
+	// Repositories without an owner belong to the Owner
+	Repositories string `json:"repositories"`
+
+	// BatchSize is the number of repositories that are summarized in a single request. The default batch size is used if it is not set
+	BatchSize int `json:"batchSize,omitempty"`
+
+	// Concurrency is the number of requests that are sent in parallel. The default concurrency is used if it is not set
+	Concurrency int `json:"concurrency,omitempty"`
+}
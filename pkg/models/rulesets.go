@@ -0,0 +1,18 @@
+package models
+
+// ListRulesetsOptions are the available options when listing the rulesets of a repository or organization
+type ListRulesetsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana). The rulesets of the organization are returned if it is empty
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository, or the organization (ex: grafana)
+	Owner string `json:"owner"`
+}
+
+// RulesetsOptionsWithRepo adds the Owner and Repository options to a ListRulesetsOptions type. This is just for convenience
+func RulesetsOptionsWithRepo(opt ListRulesetsOptions, owner string, repo string) ListRulesetsOptions {
+	return ListRulesetsOptions{
+		Owner:      owner,
+		Repository: repo,
+	}
+}
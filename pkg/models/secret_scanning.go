@@ -0,0 +1,26 @@
+package models
+
+// ListSecretScanningAlertsOptions are the available options when listing the secret scanning alerts of a repository
+type ListSecretScanningAlertsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// State filters the alerts by their state ("open" or "resolved"). All alerts are returned if it is empty
+	State string `json:"state,omitempty"`
+
+	// IncludeLocations adds a `locations` column with the number of places where every secret was found. It is not set by default, because it takes an additional request per alert
+	IncludeLocations bool `json:"includeLocations,omitempty"`
+}
+
+// SecretScanningAlertsOptionsWithRepo adds the Owner and Repository options to a ListSecretScanningAlertsOptions type. This is just for convenience
+func SecretScanningAlertsOptionsWithRepo(opt ListSecretScanningAlertsOptions, owner string, repo string) ListSecretScanningAlertsOptions {
+	return ListSecretScanningAlertsOptions{
+		Owner:            owner,
+		Repository:       repo,
+		State:            opt.State,
+		IncludeLocations: opt.IncludeLocations,
+	}
+}
@@ -11,6 +11,19 @@ type Settings struct {
 	AccessToken    string `json:"accessToken"`
 	GithubURL      string `json:"githubUrl"`
 	CachingEnabled bool   `json:"cachingEnabled"`
+
+	// UserAgent is added to the User-Agent of every request to GitHub, so that the requests of this Grafana instance can be told apart (ex: "acme-grafana")
+	UserAgent string `json:"userAgent"`
+
+	// WorkflowDispatchEnabled allows this datasource to trigger GitHub Actions workflow runs. It is disabled by default so that read-only datasources can not start runs
+	WorkflowDispatchEnabled bool `json:"workflowDispatchEnabled"`
+
+	// TLSCACert is a PEM encoded CA certificate that is trusted in addition to the system certificates, for GitHub Enterprise Servers that use a private CA.
+	// Like the access token, it is stored in the secure settings
+	TLSCACert string `json:"-"`
+
+	// TLSSkipVerify disables the verification of the certificate of the GitHub server. It should only be used for testing, because it allows the requests to be intercepted
+	TLSSkipVerify bool `json:"tlsSkipVerify"`
 }
 
 // LoadSettings converts the DataSourceInLoadSettings to usable Github settings
@@ -24,5 +37,9 @@ func LoadSettings(settings backend.DataSourceInstanceSettings) (Settings, error)
 		s.AccessToken = val
 	}
 
+	if val, ok := settings.DecryptedSecureJSONData["tlsCACert"]; ok {
+		s.TLSCACert = val
+	}
+
 	return s, nil
 }
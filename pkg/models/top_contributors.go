@@ -0,0 +1,30 @@
+package models
+
+// ListTopContributorsOptions are the available options when ranking the contributors of a repository by their activity in a time range
+type ListTopContributorsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Ref is the branch or tag whose commits are counted (ex: main). The commits of the default branch are counted if it is empty
+	Ref string `json:"ref,omitempty"`
+
+	// Limit only returns the contributors with the most activity. Every contributor is returned if it is not set
+	Limit int64 `json:"limit"`
+
+	// MinActivity removes the contributors that opened fewer issues and pull requests and authored fewer commits in total than this
+	MinActivity int64 `json:"minActivity"`
+}
+
+// TopContributorsOptionsWithRepo adds the Owner and Repository options to a ListTopContributorsOptions type
+func TopContributorsOptionsWithRepo(opt ListTopContributorsOptions, owner string, repo string) ListTopContributorsOptions {
+	return ListTopContributorsOptions{
+		Owner:       owner,
+		Repository:  repo,
+		Ref:         opt.Ref,
+		Limit:       opt.Limit,
+		MinActivity: opt.MinActivity,
+	}
+}
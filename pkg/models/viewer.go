@@ -0,0 +1,19 @@
+package models
+
+// ViewerRelation limits issue and pull request searches to the ones that are related to the authenticated user (the viewer)
+type ViewerRelation string
+
+const (
+	// ViewerNone does not limit the search to the viewer
+	ViewerNone ViewerRelation = ""
+	// ViewerAuthor only returns the issues or pull requests that the viewer opened
+	ViewerAuthor ViewerRelation = "author"
+	// ViewerAssignee only returns the issues or pull requests that are assigned to the viewer
+	ViewerAssignee ViewerRelation = "assignee"
+	// ViewerMentions only returns the issues or pull requests that mention the viewer
+	ViewerMentions ViewerRelation = "mentions"
+	// ViewerInvolves returns the issues or pull requests that the viewer opened, is assigned to, is mentioned in, or commented on
+	ViewerInvolves ViewerRelation = "involves"
+	// ViewerReviewRequested only returns the pull requests that the viewer was asked to review
+	ViewerReviewRequested ViewerRelation = "review-requested"
+)
@@ -0,0 +1,18 @@
+package models
+
+// ListWebhooksOptions are the available options when listing the webhooks of a repository or organization
+type ListWebhooksOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana). The webhooks of the organization are returned if it is empty
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository, or the organization (ex: grafana)
+	Owner string `json:"owner"`
+}
+
+// WebhooksOptionsWithRepo adds the Owner and Repository options to a ListWebhooksOptions type. This is just for convenience
+func WebhooksOptionsWithRepo(opt ListWebhooksOptions, owner string, repo string) ListWebhooksOptions {
+	return ListWebhooksOptions{
+		Owner:      owner,
+		Repository: repo,
+	}
+}
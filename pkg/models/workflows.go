@@ -0,0 +1,36 @@
+package models
+
+// WorkflowDispatchOptions are the options for triggering a GitHub Actions workflow run with the `workflow_dispatch` event
+type WorkflowDispatchOptions struct {
+	// Repository is the name of the repository (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+
+	// Workflow is the ID or the file name (ex: deploy.yml) of the workflow
+	Workflow string `json:"workflow"`
+
+	// Ref is the branch or tag that the workflow runs on
+	Ref string `json:"ref"`
+
+	// Inputs are the values of the workflow's `workflow_dispatch` inputs
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// ListWorkflowsOptions are the available options when listing the GitHub Actions workflows of a repository
+type ListWorkflowsOptions struct {
+	// Repository is the name of the repository being queried (ex: grafana)
+	Repository string `json:"repository"`
+
+	// Owner is the owner of the repository (ex: grafana)
+	Owner string `json:"owner"`
+}
+
+// WorkflowsOptionsWithRepo adds the Owner and Repository options to a ListWorkflowsOptions type. This is just for convenience
+func WorkflowsOptionsWithRepo(opt ListWorkflowsOptions, owner string, repo string) ListWorkflowsOptions {
+	return ListWorkflowsOptions{
+		Owner:      owner,
+		Repository: repo,
+	}
+}
@@ -20,6 +20,38 @@ type Datasource interface {
 	HandleLabelsQuery(context.Context, *models.LabelsQuery, backend.DataQuery) (dfutil.Framer, error)
 	HandlePackagesQuery(context.Context, *models.PackagesQuery, backend.DataQuery) (dfutil.Framer, error)
 	HandleMilestonesQuery(context.Context, *models.MilestonesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleProjectItemsQuery(context.Context, *models.ProjectItemsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleSecretScanningAlertsQuery(context.Context, *models.SecretScanningAlertsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleDeploymentsQuery(context.Context, *models.DeploymentsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleDeploymentStatusesQuery(context.Context, *models.DeploymentStatusesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleStaleIssuesQuery(context.Context, *models.StaleIssuesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandlePullRequestFilesQuery(context.Context, *models.PullRequestFilesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleIssueBurndownQuery(context.Context, *models.IssueBurndownQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleIssueFirstResponseQuery(context.Context, *models.IssueFirstResponseQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleRepoSummaryQuery(context.Context, *models.RepoSummaryQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleContributionCalendarQuery(context.Context, *models.ContributionCalendarQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleCommitAuthorsQuery(context.Context, *models.CommitAuthorsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleProjectIssuesQuery(context.Context, *models.ProjectIssuesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleIssueAssigneesQuery(context.Context, *models.IssueAssigneesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleRulesetsQuery(context.Context, *models.RulesetsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleReviewRequestsQuery(context.Context, *models.ReviewRequestsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleRateLimitQuery(context.Context, *models.RateLimitQuery, backend.DataQuery) (dfutil.Framer, error)
+	ResolveRepository(context.Context, string) (string, string, error)
+	HandleWorkflowsQuery(context.Context, *models.WorkflowsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleDiscussionsQuery(context.Context, *models.DiscussionsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleTopContributorsQuery(context.Context, *models.TopContributorsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleDeployKeysQuery(context.Context, *models.DeployKeysQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleWebhooksQuery(context.Context, *models.WebhooksQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandlePinnedIssuesQuery(context.Context, *models.PinnedIssuesQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleCommitCommentsQuery(context.Context, *models.CommitCommentsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleProjectsQuery(context.Context, *models.ProjectsQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleActivityQuery(context.Context, *models.ActivityQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleProjectTimeInStatusQuery(context.Context, *models.ProjectTimeInStatusQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleAuditLogQuery(context.Context, *models.AuditLogQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleDeploymentFrequencyQuery(context.Context, *models.DeploymentFrequencyQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleChangeFailureRateQuery(context.Context, *models.ChangeFailureRateQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleMeanTimeToRestoreQuery(context.Context, *models.MeanTimeToRestoreQuery, backend.DataQuery) (dfutil.Framer, error)
+	HandleLeadTimeForChangesQuery(context.Context, *models.LeadTimeForChangesQuery, backend.DataQuery) (dfutil.Framer, error)
 	CheckHealth(context.Context) error
 }
 
@@ -29,7 +61,23 @@ func HandleQueryData(ctx context.Context, d Datasource, req *backend.QueryDataRe
 		Datasource: d,
 	})
 
-	return m.QueryData(ctx, req)
+	// Queries that use a repository node ID are sent with the current owner and name of the repository
+	queries, failed := resolveRepositoryIDs(ctx, d, req.Queries)
+
+	res, err := m.QueryData(ctx, &backend.QueryDataRequest{
+		PluginContext: req.PluginContext,
+		Headers:       req.Headers,
+		Queries:       queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range failed {
+		res.Responses[k] = v
+	}
+
+	return res, nil
 }
 
 // CheckHealth ensures that the datasource settings are able to retrieve data from GitHub
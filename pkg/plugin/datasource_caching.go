@@ -176,6 +176,316 @@ func (c *CachedDatasource) HandleMilestonesQuery(ctx context.Context, q *models.
 	return c.saveCache(req, f, err)
 }
 
+// HandleProjectItemsQuery is the cache wrapper for the project items query handler
+func (c *CachedDatasource) HandleProjectItemsQuery(ctx context.Context, q *models.ProjectItemsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleProjectItemsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleSecretScanningAlertsQuery is the cache wrapper for the secret scanning alerts query handler
+func (c *CachedDatasource) HandleSecretScanningAlertsQuery(ctx context.Context, q *models.SecretScanningAlertsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleSecretScanningAlertsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleDeploymentsQuery is the cache wrapper for the deployments query handler
+func (c *CachedDatasource) HandleDeploymentsQuery(ctx context.Context, q *models.DeploymentsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleDeploymentsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleDeploymentStatusesQuery is the cache wrapper for the deployment statuses query handler
+func (c *CachedDatasource) HandleDeploymentStatusesQuery(ctx context.Context, q *models.DeploymentStatusesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleDeploymentStatusesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleStaleIssuesQuery is the cache wrapper for the stale issues query handler
+func (c *CachedDatasource) HandleStaleIssuesQuery(ctx context.Context, q *models.StaleIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleStaleIssuesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandlePullRequestFilesQuery is the cache wrapper for the pull request files query handler
+func (c *CachedDatasource) HandlePullRequestFilesQuery(ctx context.Context, q *models.PullRequestFilesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandlePullRequestFilesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleIssueBurndownQuery is the cache wrapper for the issue burndown query handler
+func (c *CachedDatasource) HandleIssueBurndownQuery(ctx context.Context, q *models.IssueBurndownQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleIssueBurndownQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleIssueFirstResponseQuery is the cache wrapper for the issue first response query handler
+func (c *CachedDatasource) HandleIssueFirstResponseQuery(ctx context.Context, q *models.IssueFirstResponseQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleIssueFirstResponseQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleRepoSummaryQuery is the cache wrapper for the repository summary query handler
+func (c *CachedDatasource) HandleRepoSummaryQuery(ctx context.Context, q *models.RepoSummaryQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleRepoSummaryQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleContributionCalendarQuery is the cache wrapper for the contribution calendar query handler
+func (c *CachedDatasource) HandleContributionCalendarQuery(ctx context.Context, q *models.ContributionCalendarQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleContributionCalendarQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleCommitAuthorsQuery is the cache wrapper for the commit authors query handler
+func (c *CachedDatasource) HandleCommitAuthorsQuery(ctx context.Context, q *models.CommitAuthorsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleCommitAuthorsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleProjectIssuesQuery is the cache wrapper for the project issues query handler
+func (c *CachedDatasource) HandleProjectIssuesQuery(ctx context.Context, q *models.ProjectIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleProjectIssuesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleIssueAssigneesQuery is the cache wrapper for the issue assignees query handler
+func (c *CachedDatasource) HandleIssueAssigneesQuery(ctx context.Context, q *models.IssueAssigneesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleIssueAssigneesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleRulesetsQuery is the cache wrapper for the rulesets query handler
+func (c *CachedDatasource) HandleRulesetsQuery(ctx context.Context, q *models.RulesetsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleRulesetsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleReviewRequestsQuery is the cache wrapper for the review requests query handler
+func (c *CachedDatasource) HandleReviewRequestsQuery(ctx context.Context, q *models.ReviewRequestsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleReviewRequestsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleRateLimitQuery forwards the request to the datasource and does not perform any caching, because the remaining rate limits change with every request
+func (c *CachedDatasource) HandleRateLimitQuery(ctx context.Context, q *models.RateLimitQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return c.datasource.HandleRateLimitQuery(ctx, q, req)
+}
+
+// ResolveRepository forwards the request to the datasource and does not perform any caching
+func (c *CachedDatasource) ResolveRepository(ctx context.Context, id string) (string, string, error) {
+	return c.datasource.ResolveRepository(ctx, id)
+}
+
+// HandleWorkflowsQuery is the cache wrapper for the workflows query handler
+func (c *CachedDatasource) HandleWorkflowsQuery(ctx context.Context, q *models.WorkflowsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleWorkflowsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleDiscussionsQuery is the cache wrapper for the discussions query handler
+func (c *CachedDatasource) HandleDiscussionsQuery(ctx context.Context, q *models.DiscussionsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleDiscussionsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleTopContributorsQuery is the cache wrapper for the top contributors query handler
+func (c *CachedDatasource) HandleTopContributorsQuery(ctx context.Context, q *models.TopContributorsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleTopContributorsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleDeployKeysQuery is the cache wrapper for the deploy keys query handler
+func (c *CachedDatasource) HandleDeployKeysQuery(ctx context.Context, q *models.DeployKeysQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleDeployKeysQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleWebhooksQuery is the cache wrapper for the webhooks query handler
+func (c *CachedDatasource) HandleWebhooksQuery(ctx context.Context, q *models.WebhooksQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleWebhooksQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandlePinnedIssuesQuery is the cache wrapper for the pinned issues query handler
+func (c *CachedDatasource) HandlePinnedIssuesQuery(ctx context.Context, q *models.PinnedIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandlePinnedIssuesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleCommitCommentsQuery is the cache wrapper for the commit comments query handler
+func (c *CachedDatasource) HandleCommitCommentsQuery(ctx context.Context, q *models.CommitCommentsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleCommitCommentsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleProjectsQuery is the cache wrapper for the projects query handler
+func (c *CachedDatasource) HandleProjectsQuery(ctx context.Context, q *models.ProjectsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleProjectsQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleActivityQuery is the cache wrapper for the activity query handler
+func (c *CachedDatasource) HandleActivityQuery(ctx context.Context, q *models.ActivityQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleActivityQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleProjectTimeInStatusQuery is the cache wrapper for the project time in status query handler
+func (c *CachedDatasource) HandleProjectTimeInStatusQuery(ctx context.Context, q *models.ProjectTimeInStatusQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleProjectTimeInStatusQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleAuditLogQuery is the cache wrapper for the audit log query handler
+func (c *CachedDatasource) HandleAuditLogQuery(ctx context.Context, q *models.AuditLogQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleAuditLogQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleDeploymentFrequencyQuery is the cache wrapper for the deployment frequency query handler
+func (c *CachedDatasource) HandleDeploymentFrequencyQuery(ctx context.Context, q *models.DeploymentFrequencyQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleDeploymentFrequencyQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleChangeFailureRateQuery is the cache wrapper for the change failure rate query handler
+func (c *CachedDatasource) HandleChangeFailureRateQuery(ctx context.Context, q *models.ChangeFailureRateQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleChangeFailureRateQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleMeanTimeToRestoreQuery is the cache wrapper for the mean time to restore query handler
+func (c *CachedDatasource) HandleMeanTimeToRestoreQuery(ctx context.Context, q *models.MeanTimeToRestoreQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleMeanTimeToRestoreQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
+// HandleLeadTimeForChangesQuery is the cache wrapper for the lead time for changes query handler
+func (c *CachedDatasource) HandleLeadTimeForChangesQuery(ctx context.Context, q *models.LeadTimeForChangesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	if value, err := c.getCache(req); err == nil {
+		return value, err
+	}
+
+	f, err := c.datasource.HandleLeadTimeForChangesQuery(ctx, q, req)
+	return c.saveCache(req, f, err)
+}
+
 // CheckHealth forwards the request to the datasource and does not perform any caching
 func (c *CachedDatasource) CheckHealth(ctx context.Context) error {
 	return c.datasource.CheckHealth(ctx)
@@ -66,16 +66,177 @@ func (i *Instance) HandleMilestonesQuery(ctx context.Context, q *models.Mileston
 	return i.Datasource.HandleMilestonesQuery(ctx, q, req)
 }
 
+// HandleProjectItemsQuery ...
+func (i *Instance) HandleProjectItemsQuery(ctx context.Context, q *models.ProjectItemsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleProjectItemsQuery(ctx, q, req)
+}
+
+// HandleSecretScanningAlertsQuery ...
+func (i *Instance) HandleSecretScanningAlertsQuery(ctx context.Context, q *models.SecretScanningAlertsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleSecretScanningAlertsQuery(ctx, q, req)
+}
+
+// HandleDeploymentsQuery ...
+func (i *Instance) HandleDeploymentsQuery(ctx context.Context, q *models.DeploymentsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleDeploymentsQuery(ctx, q, req)
+}
+
+// HandleDeploymentStatusesQuery ...
+func (i *Instance) HandleDeploymentStatusesQuery(ctx context.Context, q *models.DeploymentStatusesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleDeploymentStatusesQuery(ctx, q, req)
+}
+
+// HandleStaleIssuesQuery ...
+func (i *Instance) HandleStaleIssuesQuery(ctx context.Context, q *models.StaleIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleStaleIssuesQuery(ctx, q, req)
+}
+
+// HandlePullRequestFilesQuery ...
+func (i *Instance) HandlePullRequestFilesQuery(ctx context.Context, q *models.PullRequestFilesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandlePullRequestFilesQuery(ctx, q, req)
+}
+
+// HandleIssueBurndownQuery ...
+func (i *Instance) HandleIssueBurndownQuery(ctx context.Context, q *models.IssueBurndownQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleIssueBurndownQuery(ctx, q, req)
+}
+
+// HandleIssueFirstResponseQuery ...
+func (i *Instance) HandleIssueFirstResponseQuery(ctx context.Context, q *models.IssueFirstResponseQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleIssueFirstResponseQuery(ctx, q, req)
+}
+
+// HandleRepoSummaryQuery ...
+func (i *Instance) HandleRepoSummaryQuery(ctx context.Context, q *models.RepoSummaryQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleRepoSummaryQuery(ctx, q, req)
+}
+
+// HandleContributionCalendarQuery ...
+func (i *Instance) HandleContributionCalendarQuery(ctx context.Context, q *models.ContributionCalendarQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleContributionCalendarQuery(ctx, q, req)
+}
+
+// HandleCommitAuthorsQuery ...
+func (i *Instance) HandleCommitAuthorsQuery(ctx context.Context, q *models.CommitAuthorsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleCommitAuthorsQuery(ctx, q, req)
+}
+
+// HandleProjectIssuesQuery ...
+func (i *Instance) HandleProjectIssuesQuery(ctx context.Context, q *models.ProjectIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleProjectIssuesQuery(ctx, q, req)
+}
+
+// HandleIssueAssigneesQuery ...
+func (i *Instance) HandleIssueAssigneesQuery(ctx context.Context, q *models.IssueAssigneesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleIssueAssigneesQuery(ctx, q, req)
+}
+
+// HandleRulesetsQuery ...
+func (i *Instance) HandleRulesetsQuery(ctx context.Context, q *models.RulesetsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleRulesetsQuery(ctx, q, req)
+}
+
+// HandleReviewRequestsQuery ...
+func (i *Instance) HandleReviewRequestsQuery(ctx context.Context, q *models.ReviewRequestsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleReviewRequestsQuery(ctx, q, req)
+}
+
+// HandleRateLimitQuery ...
+func (i *Instance) HandleRateLimitQuery(ctx context.Context, q *models.RateLimitQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleRateLimitQuery(ctx, q, req)
+}
+
+// ResolveRepository ...
+func (i *Instance) ResolveRepository(ctx context.Context, id string) (string, string, error) {
+	return i.Datasource.ResolveRepository(ctx, id)
+}
+
+// HandleWorkflowsQuery ...
+func (i *Instance) HandleWorkflowsQuery(ctx context.Context, q *models.WorkflowsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleWorkflowsQuery(ctx, q, req)
+}
+
+// HandleDiscussionsQuery ...
+func (i *Instance) HandleDiscussionsQuery(ctx context.Context, q *models.DiscussionsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleDiscussionsQuery(ctx, q, req)
+}
+
+// HandleTopContributorsQuery ...
+func (i *Instance) HandleTopContributorsQuery(ctx context.Context, q *models.TopContributorsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleTopContributorsQuery(ctx, q, req)
+}
+
+// HandleDeployKeysQuery ...
+func (i *Instance) HandleDeployKeysQuery(ctx context.Context, q *models.DeployKeysQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleDeployKeysQuery(ctx, q, req)
+}
+
+// HandleWebhooksQuery ...
+func (i *Instance) HandleWebhooksQuery(ctx context.Context, q *models.WebhooksQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleWebhooksQuery(ctx, q, req)
+}
+
+// HandlePinnedIssuesQuery ...
+func (i *Instance) HandlePinnedIssuesQuery(ctx context.Context, q *models.PinnedIssuesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandlePinnedIssuesQuery(ctx, q, req)
+}
+
+// HandleCommitCommentsQuery ...
+func (i *Instance) HandleCommitCommentsQuery(ctx context.Context, q *models.CommitCommentsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleCommitCommentsQuery(ctx, q, req)
+}
+
+// HandleProjectsQuery ...
+func (i *Instance) HandleProjectsQuery(ctx context.Context, q *models.ProjectsQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleProjectsQuery(ctx, q, req)
+}
+
+// HandleActivityQuery ...
+func (i *Instance) HandleActivityQuery(ctx context.Context, q *models.ActivityQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleActivityQuery(ctx, q, req)
+}
+
+// HandleProjectTimeInStatusQuery ...
+func (i *Instance) HandleProjectTimeInStatusQuery(ctx context.Context, q *models.ProjectTimeInStatusQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleProjectTimeInStatusQuery(ctx, q, req)
+}
+
+// HandleAuditLogQuery ...
+func (i *Instance) HandleAuditLogQuery(ctx context.Context, q *models.AuditLogQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleAuditLogQuery(ctx, q, req)
+}
+
+// HandleDeploymentFrequencyQuery ...
+func (i *Instance) HandleDeploymentFrequencyQuery(ctx context.Context, q *models.DeploymentFrequencyQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleDeploymentFrequencyQuery(ctx, q, req)
+}
+
+// HandleChangeFailureRateQuery ...
+func (i *Instance) HandleChangeFailureRateQuery(ctx context.Context, q *models.ChangeFailureRateQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleChangeFailureRateQuery(ctx, q, req)
+}
+
+// HandleMeanTimeToRestoreQuery ...
+func (i *Instance) HandleMeanTimeToRestoreQuery(ctx context.Context, q *models.MeanTimeToRestoreQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleMeanTimeToRestoreQuery(ctx, q, req)
+}
+
+// HandleLeadTimeForChangesQuery ...
+func (i *Instance) HandleLeadTimeForChangesQuery(ctx context.Context, q *models.LeadTimeForChangesQuery, req backend.DataQuery) (dfutil.Framer, error) {
+	return i.Datasource.HandleLeadTimeForChangesQuery(ctx, q, req)
+}
+
 // CheckHealth ...
 func (i *Instance) CheckHealth(ctx context.Context) error {
 	return i.Datasource.CheckHealth(ctx)
 }
 
 // NewGitHubInstance creates a new GitHubInstance using the settings to determine if things like the Caching Wrapper should be enabled
-func NewGitHubInstance(ctx context.Context, settings models.Settings) *Instance {
-	var (
-		gh = github.NewDatasource(ctx, settings)
-	)
+func NewGitHubInstance(ctx context.Context, settings models.Settings) (*Instance, error) {
+	gh, err := github.NewDatasource(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
 
 	var d Datasource = gh
 
@@ -87,10 +248,13 @@ func NewGitHubInstance(ctx context.Context, settings models.Settings) *Instance
 	return &Instance{
 		Datasource: d,
 		Handlers: Handlers{
-			Labels:     gh.HandleGetLabels,
-			Milestones: gh.HandleGetMilestones,
+			Labels:           gh.HandleGetLabels,
+			Milestones:       gh.HandleGetMilestones,
+			Viewer:           gh.HandleGetViewer,
+			RepositoryID:     gh.HandleGetRepositoryID,
+			WorkflowDispatch: gh.HandleWorkflowDispatch,
 		},
-	}
+	}, nil
 }
 
 func newDataSourceInstance(settings backend.DataSourceInstanceSettings) (instancemgmt.Instance, error) {
@@ -101,5 +265,5 @@ func newDataSourceInstance(settings backend.DataSourceInstanceSettings) (instanc
 
 	datasourceSettings.CachingEnabled = true
 
-	return NewGitHubInstance(context.Background(), datasourceSettings), nil
+	return NewGitHubInstance(context.Background(), datasourceSettings)
 }
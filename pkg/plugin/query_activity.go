@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleActivityQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ActivityQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleActivityQuery(ctx, query, q))
+}
+
+// HandleActivity handles the plugin query for the recently updated issues and pull requests of a github repository or organization
+func (s *Server) HandleActivity(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleActivityQuery),
+	}, nil
+}
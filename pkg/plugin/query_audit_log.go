@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleAuditLogQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.AuditLogQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleAuditLogQuery(ctx, query, q))
+}
+
+// HandleAuditLog handles the plugin query for the audit log events of a GitHub organization
+func (s *Server) HandleAuditLog(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleAuditLogQuery),
+	}, nil
+}
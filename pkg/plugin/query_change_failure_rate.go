@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleChangeFailureRateQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ChangeFailureRateQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleChangeFailureRateQuery(ctx, query, q))
+}
+
+// HandleChangeFailureRate handles the plugin query for the share of the deployments of a GitHub repository that failed
+func (s *Server) HandleChangeFailureRate(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleChangeFailureRateQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleCommitCommentsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.CommitCommentsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleCommitCommentsQuery(ctx, query, q))
+}
+
+// HandleCommitComments handles the plugin query for the comments on the commits of a github repository
+func (s *Server) HandleCommitComments(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleCommitCommentsQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleContributionCalendarQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ContributionCalendarQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleContributionCalendarQuery(ctx, query, q))
+}
+
+// HandleContributionCalendar handles the plugin query for the contribution calendar of a GitHub user
+func (s *Server) HandleContributionCalendar(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleContributionCalendarQuery),
+	}, nil
+}
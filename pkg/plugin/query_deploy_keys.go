@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleDeployKeysQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.DeployKeysQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleDeployKeysQuery(ctx, query, q))
+}
+
+// HandleDeployKeys handles the plugin query for the deploy keys of a github repository
+func (s *Server) HandleDeployKeys(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleDeployKeysQuery),
+	}, nil
+}
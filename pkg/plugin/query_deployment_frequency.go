@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleDeploymentFrequencyQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.DeploymentFrequencyQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleDeploymentFrequencyQuery(ctx, query, q))
+}
+
+// HandleDeploymentFrequency handles the plugin query for the number of deployments to every environment of a GitHub repository
+func (s *Server) HandleDeploymentFrequency(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleDeploymentFrequencyQuery),
+	}, nil
+}
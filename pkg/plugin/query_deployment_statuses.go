@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleDeploymentStatusesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.DeploymentStatusesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleDeploymentStatusesQuery(ctx, query, q))
+}
+
+// HandleDeploymentStatuses handles the plugin query for the statuses of a github Deployment
+func (s *Server) HandleDeploymentStatuses(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleDeploymentStatusesQuery),
+	}, nil
+}
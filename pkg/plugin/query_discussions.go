@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleDiscussionsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.DiscussionsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleDiscussionsQuery(ctx, query, q))
+}
+
+// HandleDiscussions handles the plugin query for github discussions
+func (s *Server) HandleDiscussions(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleDiscussionsQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleIssueAssigneesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.IssueAssigneesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleIssueAssigneesQuery(ctx, query, q))
+}
+
+// HandleIssueAssignees handles the plugin query for the number of open issues per assignee in a github repository
+func (s *Server) HandleIssueAssignees(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleIssueAssigneesQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleIssueBurndownQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.IssueBurndownQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleIssueBurndownQuery(ctx, query, q))
+}
+
+// HandleIssueBurndown handles the plugin query for the number of github Issues opened and closed over time
+func (s *Server) HandleIssueBurndown(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleIssueBurndownQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleIssueFirstResponseQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.IssueFirstResponseQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleIssueFirstResponseQuery(ctx, query, q))
+}
+
+// HandleIssueFirstResponse handles the plugin query for the time until the first response to github Issues
+func (s *Server) HandleIssueFirstResponse(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleIssueFirstResponseQuery),
+	}, nil
+}
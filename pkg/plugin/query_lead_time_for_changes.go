@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleLeadTimeForChangesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.LeadTimeForChangesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleLeadTimeForChangesQuery(ctx, query, q))
+}
+
+// HandleLeadTimeForChanges handles the plugin query for the mean time from opening to merging the pull requests of a GitHub repository
+func (s *Server) HandleLeadTimeForChanges(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleLeadTimeForChangesQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleMeanTimeToRestoreQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.MeanTimeToRestoreQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleMeanTimeToRestoreQuery(ctx, query, q))
+}
+
+// HandleMeanTimeToRestore handles the plugin query for the mean time to close the incident issues of a GitHub repository
+func (s *Server) HandleMeanTimeToRestore(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleMeanTimeToRestoreQuery),
+	}, nil
+}
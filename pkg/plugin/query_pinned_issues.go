@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handlePinnedIssuesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.PinnedIssuesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandlePinnedIssuesQuery(ctx, query, q))
+}
+
+// HandlePinnedIssues handles the plugin query for the pinned issues of a github repository
+func (s *Server) HandlePinnedIssues(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handlePinnedIssuesQuery),
+	}, nil
+}
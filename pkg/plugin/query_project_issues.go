@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleProjectIssuesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ProjectIssuesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleProjectIssuesQuery(ctx, query, q))
+}
+
+// HandleProjectIssues handles the plugin query for the issues in a GitHub Project (v2) with a given status
+func (s *Server) HandleProjectIssues(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleProjectIssuesQuery),
+	}, nil
+}
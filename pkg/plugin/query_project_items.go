@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleProjectItemsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ProjectItemsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleProjectItemsQuery(ctx, query, q))
+}
+
+// HandleProjectItems handles the plugin query for github Project items
+func (s *Server) HandleProjectItems(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleProjectItemsQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleProjectTimeInStatusQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ProjectTimeInStatusQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleProjectTimeInStatusQuery(ctx, query, q))
+}
+
+// HandleProjectTimeInStatus handles the plugin query for how long the items in a GitHub Project (v2) have had their current status
+func (s *Server) HandleProjectTimeInStatus(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleProjectTimeInStatusQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handlePullRequestFilesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.PullRequestFilesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandlePullRequestFilesQuery(ctx, query, q))
+}
+
+// HandlePullRequestFiles handles the plugin query for the files changed in a github Pull Request
+func (s *Server) HandlePullRequestFiles(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handlePullRequestFilesQuery),
+	}, nil
+}
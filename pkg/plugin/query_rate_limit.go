@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleRateLimitQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.RateLimitQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleRateLimitQuery(ctx, query, q))
+}
+
+// HandleRateLimit handles the plugin query for the rate limits of the github api
+func (s *Server) HandleRateLimit(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleRateLimitQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleRepoSummaryQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.RepoSummaryQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleRepoSummaryQuery(ctx, query, q))
+}
+
+// HandleRepoSummary handles the plugin query for a summary of github Repositories
+func (s *Server) HandleRepoSummary(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleRepoSummaryQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleReviewRequestsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.ReviewRequestsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleReviewRequestsQuery(ctx, query, q))
+}
+
+// HandleReviewRequests handles the plugin query for the open github pull requests that are waiting for the review of a user
+func (s *Server) HandleReviewRequests(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleReviewRequestsQuery),
+	}, nil
+}
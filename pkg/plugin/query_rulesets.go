@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleRulesetsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.RulesetsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleRulesetsQuery(ctx, query, q))
+}
+
+// HandleRulesets handles the plugin query for the rulesets of a github repository or organization
+func (s *Server) HandleRulesets(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleRulesetsQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleSecretScanningAlertsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.SecretScanningAlertsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleSecretScanningAlertsQuery(ctx, query, q))
+}
+
+// HandleSecretScanningAlerts handles the plugin query for github secret scanning alerts
+func (s *Server) HandleSecretScanningAlerts(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleSecretScanningAlertsQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleStaleIssuesQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.StaleIssuesQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleStaleIssuesQuery(ctx, query, q))
+}
+
+// HandleStaleIssues handles the plugin query for stale github Issues
+func (s *Server) HandleStaleIssues(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleStaleIssuesQuery),
+	}, nil
+}
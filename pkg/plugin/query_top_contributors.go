@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleTopContributorsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.TopContributorsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleTopContributorsQuery(ctx, query, q))
+}
+
+// HandleTopContributors handles the plugin query for the github contributors with the most activity
+func (s *Server) HandleTopContributors(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleTopContributorsQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleWebhooksQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.WebhooksQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleWebhooksQuery(ctx, query, q))
+}
+
+// HandleWebhooks handles the plugin query for the webhooks of a github repository or organization
+func (s *Server) HandleWebhooks(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleWebhooksQuery),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/grafana/github-datasource/pkg/dfutil"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+func (s *Server) handleWorkflowsQuery(ctx context.Context, q backend.DataQuery) backend.DataResponse {
+	query := &models.WorkflowsQuery{}
+	if err := UnmarshalQuery(q.JSON, query); err != nil {
+		return *err
+	}
+	return dfutil.FrameResponseWithError(s.Datasource.HandleWorkflowsQuery(ctx, query, q))
+}
+
+// HandleWorkflows handles the plugin query for the github actions workflows of a repository
+func (s *Server) HandleWorkflows(ctx context.Context, req *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+	return &backend.QueryDataResponse{
+		Responses: processQueries(ctx, req, s.handleWorkflowsQuery),
+	}, nil
+}
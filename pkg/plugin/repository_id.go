@@ -0,0 +1,69 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/grafana/github-datasource/pkg/github"
+	"github.com/grafana/github-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+// resolveRepositoryIDs replaces the owner and repository of every query that has a repository node ID with the current owner and name of the repository.
+// Queries whose repository could not be resolved are not returned; their error responses are returned instead, keyed by their RefID
+func resolveRepositoryIDs(ctx context.Context, d Datasource, queries []backend.DataQuery) ([]backend.DataQuery, backend.Responses) {
+	var (
+		resolved = make([]backend.DataQuery, 0, len(queries))
+		failed   = backend.Responses{}
+	)
+
+	for _, v := range queries {
+		q, err := resolveRepositoryID(ctx, d, v)
+		if err != nil {
+			// The node ID is resolved before the query handler runs, so its error is explained like the errors of the handlers
+			failed[v.RefID] = backend.DataResponse{
+				Error: github.ScopeError(v.QueryType, err),
+			}
+			continue
+		}
+		resolved = append(resolved, q)
+	}
+
+	return resolved, failed
+}
+
+// resolveRepositoryID returns the query with the owner and repository set from its repository node ID. Queries without a node ID are returned unchanged
+func resolveRepositoryID(ctx context.Context, d Datasource, q backend.DataQuery) (backend.DataQuery, error) {
+	query := models.Query{}
+	if err := json.Unmarshal(q.JSON, &query); err != nil || query.RepositoryID == "" {
+		// Queries that can not be unmarshalled are left for their handler to report
+		return q, nil
+	}
+
+	owner, repo, err := d.ResolveRepository(ctx, query.RepositoryID)
+	if err != nil {
+		return q, err
+	}
+
+	// The other properties are kept as they were sent, so that the query handler receives the same options
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(q.JSON, &m); err != nil {
+		return q, err
+	}
+
+	for k, v := range map[string]string{"owner": owner, "repository": repo} {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return q, err
+		}
+		m[k] = b
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return q, err
+	}
+
+	q.JSON = b
+	return q, nil
+}
@@ -8,8 +8,11 @@ import (
 
 // Handlers stores the list of http.HandlerFunc functions for the different resource calls
 type Handlers struct {
-	Labels     http.HandlerFunc
-	Milestones http.HandlerFunc
+	Labels           http.HandlerFunc
+	Milestones       http.HandlerFunc
+	Viewer           http.HandlerFunc
+	RepositoryID     http.HandlerFunc
+	WorkflowDispatch http.HandlerFunc
 }
 
 // GetRouter creates the gorilla/mux router for the HTTP handlers
@@ -17,6 +20,9 @@ func GetRouter(h Handlers) *mux.Router {
 	router := mux.NewRouter()
 	router.Path("/labels").Methods("GET").HandlerFunc(h.Labels)
 	router.Path("/milestones").Methods("GET").HandlerFunc(h.Milestones)
+	router.Path("/viewer").Methods("GET").HandlerFunc(h.Viewer)
+	router.Path("/repository-id").Methods("GET").HandlerFunc(h.RepositoryID)
+	router.Path("/workflows/dispatch").Methods("POST").HandlerFunc(h.WorkflowDispatch)
 
 	return router
 }
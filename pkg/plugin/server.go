@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/grafana/github-datasource/pkg/github"
 	"github.com/grafana/github-datasource/pkg/models"
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
 	"github.com/grafana/grafana-plugin-sdk-go/backend/datasource"
@@ -21,7 +22,11 @@ type QueryHandlerFunc func(context.Context, backend.DataQuery) backend.DataRespo
 func processQueries(ctx context.Context, req *backend.QueryDataRequest, handler QueryHandlerFunc) backend.Responses {
 	res := backend.Responses{}
 	for _, v := range req.Queries {
-		res[v.RefID] = handler(ctx, v)
+		r := handler(ctx, v)
+
+		// GitHub's response to a token without the right scope does not say which scope the query needs
+		r.Error = github.ScopeError(v.QueryType, r.Error)
+		res[v.RefID] = r
 	}
 
 	return res
@@ -52,6 +57,37 @@ func GetQueryHandlers(s *Server) *datasource.QueryTypeMux {
 	mux.HandleFunc(models.QueryTypePackages, s.HandlePackages)
 	mux.HandleFunc(models.QueryTypeMilestones, s.HandleMilestones)
 	mux.HandleFunc(models.QueryTypeRepositories, s.HandleRepositories)
+	mux.HandleFunc(models.QueryTypeProjectItems, s.HandleProjectItems)
+	mux.HandleFunc(models.QueryTypeSecretScanningAlerts, s.HandleSecretScanningAlerts)
+	mux.HandleFunc(models.QueryTypeDeployments, s.HandleDeployments)
+	mux.HandleFunc(models.QueryTypeDeploymentStatuses, s.HandleDeploymentStatuses)
+	mux.HandleFunc(models.QueryTypeStaleIssues, s.HandleStaleIssues)
+	mux.HandleFunc(models.QueryTypePullRequestFiles, s.HandlePullRequestFiles)
+	mux.HandleFunc(models.QueryTypeIssueBurndown, s.HandleIssueBurndown)
+	mux.HandleFunc(models.QueryTypeIssueFirstResponse, s.HandleIssueFirstResponse)
+	mux.HandleFunc(models.QueryTypeRepoSummary, s.HandleRepoSummary)
+	mux.HandleFunc(models.QueryTypeContributionCalendar, s.HandleContributionCalendar)
+	mux.HandleFunc(models.QueryTypeCommitAuthors, s.HandleCommitAuthors)
+	mux.HandleFunc(models.QueryTypeProjectIssues, s.HandleProjectIssues)
+	mux.HandleFunc(models.QueryTypeIssueAssignees, s.HandleIssueAssignees)
+	mux.HandleFunc(models.QueryTypeRulesets, s.HandleRulesets)
+	mux.HandleFunc(models.QueryTypeReviewRequests, s.HandleReviewRequests)
+	mux.HandleFunc(models.QueryTypeRateLimit, s.HandleRateLimit)
+	mux.HandleFunc(models.QueryTypeWorkflows, s.HandleWorkflows)
+	mux.HandleFunc(models.QueryTypeDiscussions, s.HandleDiscussions)
+	mux.HandleFunc(models.QueryTypeTopContributors, s.HandleTopContributors)
+	mux.HandleFunc(models.QueryTypeDeployKeys, s.HandleDeployKeys)
+	mux.HandleFunc(models.QueryTypeWebhooks, s.HandleWebhooks)
+	mux.HandleFunc(models.QueryTypePinnedIssues, s.HandlePinnedIssues)
+	mux.HandleFunc(models.QueryTypeCommitComments, s.HandleCommitComments)
+	mux.HandleFunc(models.QueryTypeProjects, s.HandleProjects)
+	mux.HandleFunc(models.QueryTypeActivity, s.HandleActivity)
+	mux.HandleFunc(models.QueryTypeProjectTimeInStatus, s.HandleProjectTimeInStatus)
+	mux.HandleFunc(models.QueryTypeAuditLog, s.HandleAuditLog)
+	mux.HandleFunc(models.QueryTypeDeploymentFrequency, s.HandleDeploymentFrequency)
+	mux.HandleFunc(models.QueryTypeChangeFailureRate, s.HandleChangeFailureRate)
+	mux.HandleFunc(models.QueryTypeMeanTimeToRestore, s.HandleMeanTimeToRestore)
+	mux.HandleFunc(models.QueryTypeLeadTimeForChanges, s.HandleLeadTimeForChanges)
 
 	return mux
 }
@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"errors"
+	"net/url"
 	"testing"
 )
 
@@ -46,3 +47,48 @@ func (c *TestClient) Query(ctx context.Context, q interface{}, variables map[str
 	}
 	return nil
 }
+
+// The TestRESTClient satisfies the RESTClient interface and implements the Get and Post functions
+type TestRESTClient struct {
+	T *testing.T
+	// TestRequest can be used to check the path and URL parameters of every request
+	TestRequest func(t *testing.T, path string, params url.Values)
+	// TestBody can be used to check the body of every POST request
+	TestBody func(t *testing.T, body interface{})
+}
+
+// NewTestRESTClient creates a new TestRESTClient
+func NewTestRESTClient(t *testing.T, testRequest func(t *testing.T, path string, params url.Values)) *TestRESTClient {
+	return &TestRESTClient{
+		T:           t,
+		TestRequest: testRequest,
+	}
+}
+
+// Get calls the TestRESTClient's caller-defined function `TestRequest`
+func (c *TestRESTClient) Get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	if c.T == nil {
+		return ErrTNil
+	}
+
+	if c.TestRequest != nil {
+		c.TestRequest(c.T, path, params)
+	}
+	return nil
+}
+
+// Post calls the TestRESTClient's caller-defined functions `TestRequest`, without URL parameters, and `TestBody`
+func (c *TestRESTClient) Post(ctx context.Context, path string, body interface{}, v interface{}) error {
+	if c.T == nil {
+		return ErrTNil
+	}
+
+	if c.TestRequest != nil {
+		c.TestRequest(c.T, path, url.Values{})
+	}
+
+	if c.TestBody != nil {
+		c.TestBody(c.T, body)
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package testutil
 
-import "testing"
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
 
 // EnsureKeyIsSet ensures that a single key is set in the map (m)
 func EnsureKeyIsSet(t *testing.T, m map[string]interface{}, key string) {
@@ -22,3 +26,18 @@ func GetTestVariablesFunction(keys ...string) func(*testing.T, map[string]interf
 		EnsureKeysAreSet(t, m, keys...)
 	}
 }
+
+// GetTestRequestFunction provides a function that satisfies the TestRequest function of a TestRESTClient.
+// It ensures that the path of every request starts with the prefix, and that all of the provided URL parameters are set
+func GetTestRequestFunction(prefix string, keys ...string) func(*testing.T, string, url.Values) {
+	return func(t *testing.T, path string, params url.Values) {
+		if !strings.HasPrefix(path, prefix) {
+			t.Errorf("Unexpected request path. Expected prefix '%s', received '%s'", prefix, path)
+		}
+		for _, v := range keys {
+			if _, ok := params[v]; !ok {
+				t.Errorf("parameter '%s' is not in the URL parameters", v)
+			}
+		}
+	}
+}